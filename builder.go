@@ -0,0 +1,225 @@
+package solana
+
+import (
+	"errors"
+	"slices"
+)
+
+// Errors building a transaction. Use them with errors.Is.
+var (
+	ErrRecentBlockhashMissing       = NewSolanaError(SOLANA_ERROR__TRANSACTION__EXPECTED_BLOCKHASH_LIFETIME, "transaction has no recent blockhash, and no Rpc to fetch one")
+	ErrTransactionSignaturesMissing = NewSolanaError(SOLANA_ERROR__TRANSACTION__SIGNATURES_MISSING, "transaction is missing signatures of required signers")
+)
+
+// Builds signed transactions ready to be sent. Its methods return the builder so calls can be chained:
+//
+//	rawTx, signature, err := NewTransactionBuilder().
+//		FeePayer(payer.Pubkey).
+//		AddInstructions(instructions...).
+//		AddSigners(payer, other).
+//		WithRpc(rpc, CommitmentConfirmed).
+//		Build()
+type TransactionBuilder struct {
+	feePayer     Pubkey
+	instructions []Instruction
+	signers      []Signer
+	lookupTables []AddressLookupTable
+	blockhash    LatestBlockhash
+
+	rpc        Rpc
+	commitment Commitment
+}
+
+func NewTransactionBuilder() *TransactionBuilder {
+	return &TransactionBuilder{commitment: CommitmentFinalized}
+}
+
+// Sets the account paying the fees. Defaults to the first signer added that is also a Pubkey, like a Keypair, then to the first signer of the instructions.
+func (b *TransactionBuilder) FeePayer(feePayer Pubkey) *TransactionBuilder {
+	b.feePayer = feePayer
+	return b
+}
+
+// Appends instructions, executed in the order they are added.
+func (b *TransactionBuilder) AddInstructions(instructions ...Instruction) *TransactionBuilder {
+	b.instructions = append(b.instructions, instructions...)
+	return b
+}
+
+// Adds signers. Each built transaction is signed by the signers it requires, the others are skipped.
+func (b *TransactionBuilder) AddSigners(signers ...Signer) *TransactionBuilder {
+	b.signers = append(b.signers, signers...)
+	return b
+}
+
+// Loads non-signer accounts found in the lookup tables from them, building version 0 transactions.
+func (b *TransactionBuilder) AddLookupTables(lookupTables ...AddressLookupTable) *TransactionBuilder {
+	b.lookupTables = append(b.lookupTables, lookupTables...)
+	return b
+}
+
+// Sets the recent blockhash, as returned by GetLatestBlockhash. Its LastValidBlockHeight is the Lifetime of the built transactions.
+func (b *TransactionBuilder) RecentBlockhash(blockhash LatestBlockhash) *TransactionBuilder {
+	b.blockhash = blockhash
+	return b
+}
+
+// Fetches the latest blockhash with the commitment through rpc when building, unless RecentBlockhash was set.
+func (b *TransactionBuilder) WithRpc(rpc Rpc, commitment Commitment) *TransactionBuilder {
+	b.rpc = rpc
+	b.commitment = commitment
+	return b
+}
+
+// Returns the lifetime of the built transactions, to confirm them with SendAndConfirmTransaction.
+func (b *TransactionBuilder) Lifetime() TransactionLifetime {
+	return BlockhashLifetime{LastValidBlockHeight: b.blockhash.LastValidBlockHeight}
+}
+
+// Returns the message of a transaction with all the instructions added.
+func (b *TransactionBuilder) Message() Message {
+	return b.message(b.instructions)
+}
+
+// Returns the exact size in bytes of the serialized transaction holding all the instructions added, signatures included, to compare with MaxTransactionSize.
+func (b *TransactionBuilder) Size() (int, error) {
+	return b.size(b.instructions)
+}
+
+// Builds the transaction holding all the instructions added, signs it and returns it along with its signature, which identifies it once sent.
+// Fails with ErrTransactionSignaturesMissing if a required signer was not added, and with ErrVerifyTransactionTooLarge if the transaction does not fit in a packet, in which case BuildAll splits it.
+func (b *TransactionBuilder) Build() (RawTransaction, string, error) {
+	if err := b.fetchBlockhash(); err != nil {
+		return RawTransaction{}, "", err
+	}
+	rawTx, err := b.build(b.instructions)
+	if err != nil {
+		return RawTransaction{}, "", err
+	}
+	return rawTx, rawTx.Signatures[0], nil
+}
+
+// Like Build, but splits the instructions added into as few transactions as needed for each to fit in MaxTransactionSize bytes, keeping their order.
+// Each transaction is paid by the same fee payer and shares the same blockhash. Returns the transactions along with their signatures.
+// Fails with ErrVerifyTransactionTooLarge if a single instruction does not fit in a transaction.
+func (b *TransactionBuilder) BuildAll() ([]RawTransaction, []string, error) {
+	if err := b.fetchBlockhash(); err != nil {
+		return nil, nil, err
+	}
+	batches, err := b.split()
+	if err != nil {
+		return nil, nil, err
+	}
+	var transactions []RawTransaction
+	var signatures []string
+	for _, instructions := range batches {
+		rawTx, err := b.build(instructions)
+		if err != nil {
+			return nil, nil, err
+		}
+		transactions = append(transactions, rawTx)
+		signatures = append(signatures, rawTx.Signatures[0])
+	}
+	return transactions, signatures, nil
+}
+
+func (b *TransactionBuilder) fetchBlockhash() error {
+	if b.blockhash.Blockhash != "" {
+		return nil
+	}
+	if b.rpc == nil {
+		return ErrRecentBlockhashMissing
+	}
+	blockhash, err := b.rpc.GetLatestBlockhash(StandardRpcConfig{Commitment: &b.commitment})
+	if err != nil {
+		return err
+	}
+	b.blockhash = blockhash
+	return nil
+}
+
+func (b *TransactionBuilder) message(instructions []Instruction) Message {
+	message := Message{
+		FeePayer:        b.feePayer,
+		Instructions:    slices.Clone(instructions),
+		RecentBlockhash: b.blockhash.Blockhash,
+	}
+	if message.FeePayer == nil {
+		for _, signer := range b.signers {
+			if pubkey, ok := signer.(Pubkey); ok {
+				message.FeePayer = pubkey
+				break
+			}
+		}
+	}
+	return message
+}
+
+func (b *TransactionBuilder) build(instructions []Instruction) (RawTransaction, error) {
+	rawTx, err := Transaction{Message: b.message(instructions)}.Serialize(b.lookupTables...)
+	if err != nil {
+		return RawTransaction{}, err
+	}
+	for _, signer := range b.signers {
+		if err := rawTx.Sign(signer); err != nil && !errors.Is(err, ErrSignerNotRequired) {
+			return RawTransaction{}, err
+		}
+	}
+	if missing := rawTx.MissingSigners(); len(missing) > 0 {
+		addresses := make([]string, len(missing))
+		for i, pubkey := range missing {
+			addresses[i] = pubkey.String()
+		}
+		return RawTransaction{}, errorWithContext(ErrTransactionSignaturesMissing, map[string]any{"addresses": addresses})
+	}
+	if err := rawTx.Verify(); err != nil {
+		return RawTransaction{}, err
+	}
+	return rawTx, nil
+}
+
+// Returns the size of the signed transaction holding the instructions. Missing signatures and blockhash take as much space as real ones.
+func (b *TransactionBuilder) size(instructions []Instruction) (int, error) {
+	message := b.message(instructions)
+	if message.RecentBlockhash == "" {
+		message.RecentBlockhash = SystemProgram.String()
+	}
+	rawTx, err := Transaction{Message: message}.Serialize(b.lookupTables...)
+	if err != nil {
+		return 0, err
+	}
+	rawTx.Signatures = make([]string, rawTx.Message.Header.NumRequiredSignatures)
+	data, err := rawTx.Bytes()
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Groups consecutive instructions into batches filling transactions up to MaxTransactionSize bytes.
+func (b *TransactionBuilder) split() ([][]Instruction, error) {
+	var batches [][]Instruction
+	var batch []Instruction
+	for i := 0; i < len(b.instructions); {
+		candidate := append(slices.Clone(batch), b.instructions[i])
+		size, err := b.size(candidate)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case size <= MaxTransactionSize:
+			batch = candidate
+			i++
+		case len(batch) == 0:
+			return nil, verifyError(ErrVerifyTransactionTooLarge, i, map[string]any{"size": size, "maxSize": MaxTransactionSize})
+		default:
+			//The instruction starts the next batch
+			batches = append(batches, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
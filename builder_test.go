@@ -0,0 +1,103 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type builderTestRpc struct {
+	RpcContext
+	calls int
+}
+
+func (r *builderTestRpc) GetLatestBlockhash(ctx context.Context, config ...StandardRpcConfig) (LatestBlockhash, error) {
+	r.calls++
+	return LatestBlockhash{Blockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa", LastValidBlockHeight: 100}, nil
+}
+
+func TestTransactionBuilder(t *testing.T) {
+	payer, owner := newTestKeypair(t, 1), newTestKeypair(t, 2)
+	recipient := newTestKeypair(t, 3).Pubkey
+	rpc := &builderTestRpc{}
+
+	builder := NewTransactionBuilder().
+		FeePayer(payer.Pubkey).
+		AddInstructions(SystemProgramInstructions().Transfer(owner.Pubkey, recipient, 1)).
+		AddSigners(owner, payer, newTestKeypair(t, 4)).
+		WithRpc(BackgroundRpc(rpc), CommitmentConfirmed)
+	rawTx, signature, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rpc.calls != 1 || rawTx.Message.RecentBlockhash != "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa" {
+		t.Fatal("Expected the blockhash to be fetched", rawTx.Message.RecentBlockhash)
+	}
+	if rawTx.Message.AccountKeys[0].String() != payer.Pubkey.String() || signature != rawTx.Signatures[0] || len(rawTx.Signatures) != 2 {
+		t.Fatal("Expected the fee payer's signature first", rawTx.Signatures)
+	}
+	if lifetime := builder.Lifetime(); lifetime != (BlockhashLifetime{LastValidBlockHeight: 100}) {
+		t.Fatal("Unexpected lifetime", lifetime)
+	}
+
+	size, err := builder.Size()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rawTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != len(data) {
+		t.Fatal("Expected a size of", len(data), "got", size)
+	}
+
+	_, _, err = NewTransactionBuilder().AddInstructions(SystemProgramInstructions().Transfer(owner.Pubkey, recipient, 1)).AddSigners(payer).WithRpc(BackgroundRpc(rpc), CommitmentConfirmed).Build()
+	if !errors.Is(err, ErrTransactionSignaturesMissing) {
+		t.Fatal("Expected the owner's signature to be missing, got", err)
+	}
+	_, _, err = NewTransactionBuilder().AddInstructions(SystemProgramInstructions().Transfer(owner.Pubkey, recipient, 1)).AddSigners(owner).Build()
+	if !errors.Is(err, ErrRecentBlockhashMissing) {
+		t.Fatal("Expected the blockhash to be missing, got", err)
+	}
+}
+
+func TestTransactionBuilderSplit(t *testing.T) {
+	payer := newTestKeypair(t, 1)
+	memo := func(size int) Instruction {
+		return Instruction{ProgramID: newTestKeypair(t, 9).Pubkey, Accounts: []AccountMeta{{Pubkey: payer.Pubkey, Signer: true}}, Data: make([]byte, size)}
+	}
+	builder := NewTransactionBuilder().AddSigners(payer).RecentBlockhash(LatestBlockhash{Blockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa"})
+	for range 5 {
+		builder.AddInstructions(memo(400))
+	}
+	if _, _, err := builder.Build(); !errors.Is(err, ErrVerifyTransactionTooLarge) {
+		t.Fatal("Expected the transaction to be too large, got", err)
+	}
+
+	transactions, signatures, err := builder.BuildAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 3 || len(signatures) != 3 {
+		t.Fatal("Expected 2 instructions per transaction, got", len(transactions), "transactions")
+	}
+	for i, rawTx := range transactions {
+		data, err := rawTx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) > MaxTransactionSize || signatures[i] != rawTx.Signatures[0] {
+			t.Fatal("Unexpected transaction", i, len(data))
+		}
+	}
+	if len(transactions[2].Message.Instructions) != 1 {
+		t.Fatal("Expected the last instruction alone")
+	}
+
+	_, _, err = NewTransactionBuilder().AddSigners(payer).RecentBlockhash(LatestBlockhash{Blockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa"}).AddInstructions(memo(10), memo(MaxTransactionSize)).BuildAll()
+	var solanaErr *SolanaError
+	if !errors.As(err, &solanaErr) || !errors.Is(err, ErrVerifyTransactionTooLarge) || solanaErr.Context["index"] != 1 {
+		t.Fatal("Expected the second instruction to be too large, got", err)
+	}
+}
@@ -1,6 +1,7 @@
 package solana
 
 import (
+	"context"
 	"encoding/base64"
 )
 
@@ -12,7 +13,10 @@ type Client interface {
 	GetTokenAccountBalance(pubkey Pubkey) (UiTokenAmount, error)
 	RecentBlockhash() (string, error)
 	SendTransaction(transaction Transaction) (string, error)
-	SendAndSignTransaction(transaction Transaction) (string, error) //Signs the transaction with the Client's default signer and handles getting the recent blockhash
+	SendAndSignTransaction(transaction Transaction) (string, error)                     //Signs the transaction with the Client's default signer and handles getting the recent blockhash. Transactions advancing a durable nonce keep the nonce as their blockhash.
+	SendAndConfirmTransaction(transaction Transaction) (TransactionConfirmation, error) //Like SendAndSignTransaction, but rebroadcasts the transaction until it reaches the Client's default commitment or its blockhash or nonce expires
+	GetNonceAccount(pubkey Pubkey) (NonceAccount, error)                                //Returns the state of a nonce account, whose nonce can be used with NewNonceMessage
+	EstimateFee(transaction Transaction) (FeeEstimate, error)                           //Returns the fee the transaction's fee payer will be charged. Transactions without a recent blockhash are estimated with the latest one.
 
 	Rpc() Rpc
 	Signer
@@ -24,10 +28,25 @@ type client struct {
 	Keypair
 
 	DefaultCommitment Commitment
+	computeBudget     *ComputeBudgetConfig
 }
 
-func NewClient(rpc Rpc, keypair Keypair) Client {
-	return &client{rpc: rpc, Keypair: keypair, DefaultCommitment: CommitmentFinalized}
+// Configures a Client.
+type ClientOption func(*client)
+
+// Sizes the compute budget of every transaction the Client signs with SizeComputeBudget: the compute unit limit comes from a simulation, and the unit price from the prioritization fees recently paid for the transaction's writable accounts.
+func WithAutoComputeBudget(config ComputeBudgetConfig) ClientOption {
+	return func(c *client) {
+		c.computeBudget = &config
+	}
+}
+
+func NewClient(rpc Rpc, keypair Keypair, opts ...ClientOption) Client {
+	c := &client{rpc: rpc, Keypair: keypair, DefaultCommitment: CommitmentFinalized}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *client) Rpc() Rpc {
@@ -63,7 +82,10 @@ func (c *client) RecentBlockhash() (string, error) {
 }
 
 func (c *client) SendTransaction(transaction Transaction) (string, error) {
-	rawTx := transaction.Serialize()
+	rawTx, err := transaction.Serialize()
+	if err != nil {
+		return "", err
+	}
 	txBytes, err := rawTx.Bytes()
 	if err != nil {
 		return "", err
@@ -75,13 +97,61 @@ func (c *client) SendTransaction(transaction Transaction) (string, error) {
 }
 
 func (c *client) SendAndSignTransaction(transaction Transaction) (string, error) {
-	blockhash, err := c.RecentBlockhash()
-	if err != nil {
+	if _, err := c.signWithLifetime(&transaction); err != nil {
 		return "", err
 	}
+	return c.SendTransaction(transaction)
+}
 
-	transaction.Message.RecentBlockhash = blockhash
-	transaction.Sign(c)
+func (c *client) SendAndConfirmTransaction(transaction Transaction) (TransactionConfirmation, error) {
+	lifetime, err := c.signWithLifetime(&transaction)
+	if err != nil {
+		return TransactionConfirmation{}, err
+	}
+	rawTx, err := transaction.Serialize()
+	if err != nil {
+		return TransactionConfirmation{}, err
+	}
+	return SendAndConfirmTransaction(context.Background(), ContextRpc(c.rpc), rawTx, lifetime, WithConfirmCommitment(c.DefaultCommitment))
+}
 
-	return c.SendTransaction(transaction)
+// Signs the transaction with the Client's signer, after sizing its compute budget if enabled, and returns the lifetime its confirmation is bound to. Transactions advancing a durable nonce keep the nonce as their blockhash, every other transaction gets the latest blockhash.
+func (c *client) signWithLifetime(transaction *Transaction) (TransactionLifetime, error) {
+	var lifetime TransactionLifetime
+	if nonceAccount, ok := transaction.Message.nonceAccount(); ok {
+		lifetime = NonceLifetime{NonceAccount: nonceAccount, Nonce: transaction.Message.RecentBlockhash}
+	} else {
+		blockhash, err := c.rpc.GetLatestBlockhash(StandardRpcConfig{Commitment: &c.DefaultCommitment})
+		if err != nil {
+			return nil, err
+		}
+		transaction.Message.RecentBlockhash = blockhash.Blockhash
+		lifetime = BlockhashLifetime{LastValidBlockHeight: blockhash.LastValidBlockHeight}
+	}
+	if c.computeBudget != nil {
+		message, err := SizeComputeBudget(context.Background(), ContextRpc(c.rpc), transaction.Message, *c.computeBudget)
+		if err != nil {
+			return nil, err
+		}
+		transaction.Message = message
+	}
+	if err := transaction.Sign(c); err != nil {
+		return nil, err
+	}
+	return lifetime, nil
+}
+
+func (c *client) GetNonceAccount(pubkey Pubkey) (NonceAccount, error) {
+	return GetNonceAccount(context.Background(), ContextRpc(c.rpc), pubkey, c.DefaultCommitment)
+}
+
+func (c *client) EstimateFee(transaction Transaction) (FeeEstimate, error) {
+	if transaction.Message.RecentBlockhash == "" {
+		blockhash, err := c.RecentBlockhash()
+		if err != nil {
+			return FeeEstimate{}, err
+		}
+		transaction.Message.RecentBlockhash = blockhash
+	}
+	return EstimateFee(context.Background(), ContextRpc(c.rpc), transaction.Message, c.DefaultCommitment)
 }
@@ -0,0 +1,237 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"math"
+	"slices"
+
+	"github.com/near/borsh-go"
+)
+
+// Instruction discriminants of the Compute Budget Program, encoded as a u8.
+const (
+	computeBudgetRequestHeapFrame               uint8 = 1
+	computeBudgetSetComputeUnitLimit            uint8 = 2
+	computeBudgetSetComputeUnitPrice            uint8 = 3
+	computeBudgetSetLoadedAccountsDataSizeLimit uint8 = 4
+)
+
+const (
+	MaxComputeUnitLimit = 1_400_000 //Most compute units a transaction can request
+
+	defaultComputeUnitMargin     = 0.1
+	defaultPrioritizationPercent = 75
+	maxPrioritizationFeeAccounts = 128 //Most accounts getRecentPrioritizationFees accepts
+)
+
+// Errors sizing a compute budget. Use them with errors.Is.
+var (
+	ErrComputeLimitEstimation = NewSolanaError(SOLANA_ERROR__TRANSACTION__FAILED_TO_ESTIMATE_COMPUTE_LIMIT, "simulation did not report the compute units consumed")
+	ErrComputeLimitSimulation = NewSolanaError(SOLANA_ERROR__TRANSACTION__FAILED_WHEN_SIMULATING_TO_ESTIMATE_COMPUTE_LIMIT, "transaction failed when simulating it to estimate its compute limit")
+)
+
+type ComputeBudgetProgramIxs interface {
+	SetComputeUnitLimit(units uint32) Instruction            //Sets the compute units the transaction may consume, up to MaxComputeUnitLimit. Defaults to 200,000 per instruction.
+	SetComputeUnitPrice(microLamports uint64) Instruction    //Sets the prioritization fee paid per compute unit, in micro-lamports
+	RequestHeapFrame(bytes uint32) Instruction               //Requests a heap of the given size, a multiple of 1024 between 32KiB and 256KiB
+	SetLoadedAccountsDataSizeLimit(bytes uint32) Instruction //Sets the most account data bytes the transaction may load
+}
+
+func ComputeBudgetProgramInstructions() ComputeBudgetProgramIxs {
+	return &computeBudgetProgramIxs{}
+}
+
+type computeBudgetProgramIxs struct{}
+
+func (computeBudgetProgramIxs) SetComputeUnitLimit(units uint32) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Units       uint32
+	}{
+		Instruction: computeBudgetSetComputeUnitLimit,
+		Units:       units,
+	})
+	return Instruction{ProgramID: ComputeBudgetProgram, Data: data}
+}
+
+func (computeBudgetProgramIxs) SetComputeUnitPrice(microLamports uint64) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction   uint8
+		MicroLamports uint64
+	}{
+		Instruction:   computeBudgetSetComputeUnitPrice,
+		MicroLamports: microLamports,
+	})
+	return Instruction{ProgramID: ComputeBudgetProgram, Data: data}
+}
+
+func (computeBudgetProgramIxs) RequestHeapFrame(bytes uint32) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Bytes       uint32
+	}{
+		Instruction: computeBudgetRequestHeapFrame,
+		Bytes:       bytes,
+	})
+	return Instruction{ProgramID: ComputeBudgetProgram, Data: data}
+}
+
+func (computeBudgetProgramIxs) SetLoadedAccountsDataSizeLimit(bytes uint32) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Bytes       uint32
+	}{
+		Instruction: computeBudgetSetLoadedAccountsDataSizeLimit,
+		Bytes:       bytes,
+	})
+	return Instruction{ProgramID: ComputeBudgetProgram, Data: data}
+}
+
+// Configures how SizeComputeBudget sizes a transaction's compute budget. The zero value uses the defaults.
+type ComputeBudgetConfig struct {
+	UnitMargin    float64 //Fraction of the simulated compute units added as a safety margin. Defaults to 0.1.
+	FeePercentile float64 //Percentile of the recent prioritization fees paid for the transaction's writable accounts to pay, between 0 and 100. Defaults to 75.
+	MaxUnitPrice  uint64  //Highest compute unit price to pay, in micro-lamports. Zero means no limit.
+	NoUnitPrice   bool    //Only sets the compute unit limit, leaving the prioritization fee out
+}
+
+// Returns the message with SetComputeUnitLimit and SetComputeUnitPrice instructions prepended.
+// The limit is the compute units consumed by a simulation of the message, budget instructions included, plus a safety margin. The price is a percentile of the prioritization fees recently paid for the message's writable accounts, from GetRecentPrioritizationFees.
+// Limits and prices already set by the message's instructions are kept. The message does not need to be signed nor to have a recent blockhash.
+func SizeComputeBudget(ctx context.Context, rpc RpcContext, message Message, config ComputeBudgetConfig) (Message, error) {
+	if config.UnitMargin == 0 {
+		config.UnitMargin = defaultComputeUnitMargin
+	}
+	if config.FeePercentile == 0 {
+		config.FeePercentile = defaultPrioritizationPercent
+	}
+
+	setLimit := !message.hasComputeBudgetInstruction(computeBudgetSetComputeUnitLimit)
+	setPrice := !config.NoUnitPrice && !message.hasComputeBudgetInstruction(computeBudgetSetComputeUnitPrice)
+
+	//The budget is inserted with placeholder values first, so the simulation also counts the compute units of its own instructions
+	var budget []Instruction
+	if setLimit {
+		budget = append(budget, ComputeBudgetProgramInstructions().SetComputeUnitLimit(MaxComputeUnitLimit))
+	}
+	if setPrice {
+		budget = append(budget, ComputeBudgetProgramInstructions().SetComputeUnitPrice(0))
+	}
+	//A durable nonce must stay advanced by the first instruction
+	position := 0
+	if _, ok := message.nonceAccount(); ok {
+		position = 1
+	}
+	message.Instructions = slices.Insert(slices.Clone(message.Instructions), position, budget...)
+
+	if setLimit {
+		units, err := simulateComputeUnits(ctx, rpc, message)
+		if err != nil {
+			return Message{}, err
+		}
+		limit := min(math.Ceil(float64(units)*(1+config.UnitMargin)), MaxComputeUnitLimit)
+		message.Instructions[position] = ComputeBudgetProgramInstructions().SetComputeUnitLimit(uint32(limit))
+	}
+	if setPrice {
+		price, err := recentUnitPrice(ctx, rpc, message, config.FeePercentile)
+		if err != nil {
+			return Message{}, err
+		}
+		if config.MaxUnitPrice > 0 {
+			price = min(price, config.MaxUnitPrice)
+		}
+		message.Instructions[position+len(budget)-1] = ComputeBudgetProgramInstructions().SetComputeUnitPrice(price)
+	}
+	return message, nil
+}
+
+// Returns the compute units consumed by a simulation of the message, which must already set the highest compute unit limit so it cannot run out.
+func simulateComputeUnits(ctx context.Context, rpc RpcContext, message Message) (uint, error) {
+	//The node replaces the blockhash, but it still has to be 32 bytes
+	if message.RecentBlockhash == "" {
+		message.RecentBlockhash = SystemProgram.String()
+	}
+	rawTx, err := Transaction{Message: message}.Serialize()
+	if err != nil {
+		return 0, err
+	}
+	rawTx.Signatures = make([]string, rawTx.Message.Header.NumRequiredSignatures)
+	data, err := rawTx.Bytes()
+	if err != nil {
+		return 0, err
+	}
+
+	sigVerify, replaceRecentBlockhash := false, true
+	encoding := EncodingBase64
+	res, err := rpc.SimulateTransaction(ctx, base64.StdEncoding.EncodeToString(data), SimulateTransactionConfig{
+		SigVerify:              &sigVerify,
+		ReplaceRecentBlockhash: &replaceRecentBlockhash,
+		Encoding:               &encoding,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res.Err != nil {
+		err := *ErrComputeLimitSimulation
+		err.Cause = res.Err
+		err.Context = map[string]any{"logs": res.Logs}
+		return 0, &err
+	}
+	if res.UnitsConsumed == nil {
+		return 0, ErrComputeLimitEstimation
+	}
+	return *res.UnitsConsumed, nil
+}
+
+// Returns the percentile of the prioritization fees recently paid by transactions writing to the message's writable accounts.
+func recentUnitPrice(ctx context.Context, rpc RpcContext, message Message, percentile float64) (uint64, error) {
+	var writable []Pubkey
+	seen := map[string]bool{}
+	add := func(pubkey Pubkey) {
+		if pubkey != nil && !seen[pubkey.String()] && len(writable) < maxPrioritizationFeeAccounts {
+			seen[pubkey.String()] = true
+			writable = append(writable, pubkey)
+		}
+	}
+	add(message.FeePayer)
+	for _, instruction := range message.Instructions {
+		for _, account := range instruction.Accounts {
+			if account.Writable {
+				add(account.Pubkey)
+			}
+		}
+	}
+
+	fees, err := rpc.GetRecentPrioritizationFees(ctx, writable)
+	if err != nil {
+		return 0, err
+	}
+	if len(fees) == 0 {
+		return 0, nil
+	}
+	prices := make([]uint, len(fees))
+	for i, fee := range fees {
+		prices[i] = fee.PrioritizationFee
+	}
+	slices.Sort(prices)
+	//Nearest rank percentile
+	rank := int(math.Ceil(min(max(percentile, 0), 100) / 100 * float64(len(prices))))
+	return uint64(prices[max(rank-1, 0)]), nil
+}
+
+// Returns whether an instruction of the message is a Compute Budget Program instruction of the given kind.
+func (m Message) hasComputeBudgetInstruction(kind uint8) bool {
+	_, ok := m.computeBudgetInstruction(kind)
+	return ok
+}
+
+// Returns the data of the first Compute Budget Program instruction of the given kind, after its discriminant.
+func (m Message) computeBudgetInstruction(kind uint8) ([]byte, bool) {
+	for _, instruction := range m.Instructions {
+		if instruction.ProgramID != nil && instruction.ProgramID.String() == ComputeBudgetProgram.String() && len(instruction.Data) > 0 && instruction.Data[0] == kind {
+			return instruction.Data[1:], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,136 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestComputeBudgetInstructions(t *testing.T) {
+	ixs := ComputeBudgetProgramInstructions()
+	tests := []struct {
+		instruction Instruction
+		expected    []byte
+	}{
+		{ixs.RequestHeapFrame(256 * 1024), []byte{1, 0, 0, 4, 0}},
+		{ixs.SetComputeUnitLimit(200_000), []byte{2, 64, 13, 3, 0}},
+		{ixs.SetComputeUnitPrice(1_000), []byte{3, 232, 3, 0, 0, 0, 0, 0, 0}},
+		{ixs.SetLoadedAccountsDataSizeLimit(65_536), []byte{4, 0, 0, 1, 0}},
+	}
+	for _, test := range tests {
+		if slices.Compare(test.instruction.Data, test.expected) != 0 || len(test.instruction.Accounts) != 0 {
+			t.Fatal("Unexpected instruction", test.instruction.Data, "expected", test.expected)
+		}
+	}
+}
+
+// Simulates transactions consuming a fixed number of compute units, and reports fixed prioritization fees.
+type computeBudgetTestRpc struct {
+	RpcContext
+	units     uint
+	txErr     *TransactionError
+	fees      []uint
+	simulated RawTransaction
+	addresses []Pubkey
+}
+
+func (r *computeBudgetTestRpc) SimulateTransaction(ctx context.Context, transaction string, config ...SimulateTransactionConfig) (SimulateTransactionResult, error) {
+	data, err := base64.StdEncoding.DecodeString(transaction)
+	if err != nil {
+		return SimulateTransactionResult{}, err
+	}
+	if r.simulated, err = ParseTransactionData(data); err != nil {
+		return SimulateTransactionResult{}, err
+	}
+	return SimulateTransactionResult{UnitsConsumed: &r.units, Err: r.txErr}, nil
+}
+
+func (r *computeBudgetTestRpc) GetRecentPrioritizationFees(ctx context.Context, addresses []Pubkey) ([]PrioritizationFee, error) {
+	r.addresses = addresses
+	fees := make([]PrioritizationFee, len(r.fees))
+	for i, fee := range r.fees {
+		fees[i] = PrioritizationFee{Slot: uint(i), PrioritizationFee: fee}
+	}
+	return fees, nil
+}
+
+func TestSizeComputeBudget(t *testing.T) {
+	payer := newTestKeypair(t, 1).Pubkey
+	recipient := newTestKeypair(t, 2).Pubkey
+	message := Message{Instructions: []Instruction{SystemProgramInstructions().Transfer(payer, recipient, 1)}}
+	rpc := &computeBudgetTestRpc{units: 1000, fees: []uint{40, 0, 10, 30, 20}}
+
+	sized, err := SizeComputeBudget(context.Background(), rpc, message, ComputeBudgetConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sized.Instructions) != 3 || len(message.Instructions) != 1 {
+		t.Fatal("Expected the budget to be prepended to a copy of the instructions", sized.Instructions)
+	}
+	if limit := binary.LittleEndian.Uint32(sized.Instructions[0].Data[1:]); limit != 1100 {
+		t.Fatal("Expected the simulated units plus a 10% margin, got", limit)
+	}
+	if price := binary.LittleEndian.Uint64(sized.Instructions[1].Data[1:]); price != 30 {
+		t.Fatal("Expected the 75th percentile of the fees, got", price)
+	}
+	if len(rpc.addresses) != 2 || rpc.addresses[0].String() != payer.String() {
+		t.Fatal("Expected the fees of the writable accounts", rpc.addresses)
+	}
+	checkSimulatedAsSent(t, rpc.simulated, sized)
+	//The simulation runs with the highest limit, so it cannot run out of compute units
+	if simulated := rpc.simulated.Message.Instructions[0]; binary.LittleEndian.Uint32(simulated.Data[1:]) != MaxComputeUnitLimit {
+		t.Fatal("Unexpected simulated instruction", simulated)
+	}
+
+	//Budgets set by the caller are kept
+	message.Instructions = append(message.Instructions, ComputeBudgetProgramInstructions().SetComputeUnitPrice(5))
+	sized, err = SizeComputeBudget(context.Background(), rpc, message, ComputeBudgetConfig{UnitMargin: 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sized.Instructions) != 3 || binary.LittleEndian.Uint32(sized.Instructions[0].Data[1:]) != 1500 {
+		t.Fatal("Unexpected instructions", sized.Instructions)
+	}
+
+	rpc.txErr = &TransactionError{Kind: "InsufficientFundsForFee"}
+	_, err = SizeComputeBudget(context.Background(), rpc, message, ComputeBudgetConfig{})
+	if !errors.Is(err, ErrComputeLimitSimulation) || !errors.Is(err, ErrTransactionInsufficientFundsForFee) {
+		t.Fatal("Expected the simulation to fail, got", err)
+	}
+}
+
+func TestSizeComputeBudgetNonce(t *testing.T) {
+	authority := newTestKeypair(t, 1).Pubkey
+	nonce := NonceAccount{Authority: authority, Nonce: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa"}
+	message := NewNonceMessage(newTestKeypair(t, 2).Pubkey, nonce, SystemProgramInstructions().Transfer(authority, newTestKeypair(t, 3).Pubkey, 1))
+
+	rpc := &computeBudgetTestRpc{units: 1000}
+	sized, err := SizeComputeBudget(context.Background(), rpc, message, ComputeBudgetConfig{MaxUnitPrice: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sized.nonceAccount(); !ok {
+		t.Fatal("Expected the nonce to still be advanced first")
+	}
+	checkSimulatedAsSent(t, rpc.simulated, sized)
+	if len(sized.Instructions) != 4 || sized.Instructions[1].ProgramID.String() != ComputeBudgetProgram.String() {
+		t.Fatal("Unexpected instructions", sized.Instructions)
+	}
+}
+
+// Checks the simulated transaction runs the same instructions as the sized message, as each Compute Budget Program instruction consumes compute units too.
+func checkSimulatedAsSent(t *testing.T, simulated RawTransaction, sent Message) {
+	t.Helper()
+	if len(simulated.Message.Instructions) != len(sent.Instructions) {
+		t.Fatal("Expected the simulation to run the instructions sent", simulated.Message.Instructions, sent.Instructions)
+	}
+	for i, instruction := range simulated.Message.Instructions {
+		programID := simulated.Message.AccountKeys[instruction.ProgramIDIndex]
+		if programID.String() != sent.Instructions[i].ProgramID.String() || instruction.Data[0] != sent.Instructions[i].Data[0] {
+			t.Fatal("Expected simulated instruction", i, "to match the one sent", instruction, sent.Instructions[i])
+		}
+	}
+}
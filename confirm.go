@@ -0,0 +1,255 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+)
+
+const (
+	defaultConfirmPollInterval = 500 * time.Millisecond
+	defaultRebroadcastInterval = 2 * time.Second
+)
+
+// Errors confirming a transaction. Use them with errors.Is.
+var (
+	ErrBlockhashExpired         = NewSolanaError(SOLANA_ERROR__BLOCK_HEIGHT_EXCEEDED, "block height exceeded the last valid block height of the transaction's blockhash")
+	ErrFeePayerSignatureMissing = NewSolanaError(SOLANA_ERROR__TRANSACTION__FEE_PAYER_SIGNATURE_MISSING, "transaction is not signed by its fee payer")
+)
+
+// Outcome of a confirmed transaction.
+type TransactionConfirmation struct {
+	Signature          string            //The transaction's signature, identifying it
+	Slot               uint              //The slot the transaction was processed in
+	ConfirmationStatus Commitment        //The commitment the transaction reached
+	Err                *TransactionError //Error the transaction failed with, nil if it succeeded
+}
+
+// Tells when a transaction can no longer land, which ends its confirmation with an error.
+type TransactionLifetime interface {
+	Expired(ctx context.Context, rpc RpcContext, commitment Commitment) (bool, error) //Returns whether the transaction can no longer land. Failed checks are retried on the next poll.
+	ExpiredError() error                                                              //Returns the error confirming an expired transaction fails with, e.g. ErrBlockhashExpired
+}
+
+// Lifetime of a transaction using a recent blockhash. Once the block height passes LastValidBlockHeight, as returned by GetLatestBlockhash along with the blockhash, the transaction can no longer land.
+type BlockhashLifetime struct {
+	LastValidBlockHeight uint //Last block height at which the blockhash is valid
+}
+
+func (l BlockhashLifetime) Expired(ctx context.Context, rpc RpcContext, commitment Commitment) (bool, error) {
+	height, err := rpc.GetBlockHeight(ctx, StandardRpcConfig{Commitment: &commitment})
+	if err != nil {
+		return false, err
+	}
+	return height > l.LastValidBlockHeight, nil
+}
+
+func (l BlockhashLifetime) ExpiredError() error {
+	return ErrBlockhashExpired
+}
+
+// Notifies the confirmation of a transaction as soon as it happens, e.g. an rpc.WsClient through a signature subscription.
+type SignatureSubscriber interface {
+	// Blocks until the transaction with the signature reaches the commitment. Returns the slot it was processed in and the error it failed with, if any.
+	WaitForSignature(ctx context.Context, signature string, commitment Commitment) (uint, *TransactionError, error)
+}
+
+// Configures SendAndConfirmTransaction and ConfirmTransaction.
+type ConfirmOption func(*confirmConfig)
+
+type confirmConfig struct {
+	commitment          Commitment
+	pollInterval        time.Duration
+	rebroadcastInterval time.Duration
+	subscriber          SignatureSubscriber
+}
+
+// Sets the commitment to wait for. Defaults to CommitmentFinalized.
+func WithConfirmCommitment(commitment Commitment) ConfirmOption {
+	return func(c *confirmConfig) {
+		c.commitment = commitment
+	}
+}
+
+// Sets how often the status of the transaction and its expiry are polled. Defaults to 500ms.
+func WithConfirmPollInterval(interval time.Duration) ConfirmOption {
+	return func(c *confirmConfig) {
+		c.pollInterval = interval
+	}
+}
+
+// Sets how often SendAndConfirmTransaction sends the transaction again until it is confirmed. Defaults to 2s. Zero disables rebroadcasting, leaving retries to the RPC node.
+func WithRebroadcastInterval(interval time.Duration) ConfirmOption {
+	return func(c *confirmConfig) {
+		c.rebroadcastInterval = interval
+	}
+}
+
+// Waits for a notification from subscriber alongside polling, so the confirmation is seen as soon as it happens.
+func WithSignatureSubscriber(subscriber SignatureSubscriber) ConfirmOption {
+	return func(c *confirmConfig) {
+		c.subscriber = subscriber
+	}
+}
+
+func newConfirmConfig(opts []ConfirmOption) confirmConfig {
+	config := confirmConfig{
+		commitment:          CommitmentFinalized,
+		pollInterval:        defaultConfirmPollInterval,
+		rebroadcastInterval: defaultRebroadcastInterval,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// Sends the signed transaction and waits until it reaches the commitment, sending it again on every rebroadcast interval until then.
+// Returns an error once lifetime says the transaction expired, e.g. ErrBlockhashExpired. A transaction failing on chain is returned along with its TransactionError.
+func SendAndConfirmTransaction(ctx context.Context, rpc RpcContext, transaction RawTransaction, lifetime TransactionLifetime, opts ...ConfirmOption) (TransactionConfirmation, error) {
+	config := newConfirmConfig(opts)
+	if len(transaction.Signatures) == 0 || transaction.Signatures[0] == "" {
+		return TransactionConfirmation{}, ErrFeePayerSignatureMissing
+	}
+	data, err := transaction.Bytes()
+	if err != nil {
+		return TransactionConfirmation{}, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(data)
+	encoding := EncodingBase64
+	var maxRetries uint
+	send := func(skipPreflight bool) error {
+		sendConfig := SendTransactionConfig{Encoding: &encoding, SkipPreflight: &skipPreflight, PreflightCommitment: &config.commitment}
+		//The transaction is rebroadcast here, so the node should not queue its own retries
+		if config.rebroadcastInterval > 0 {
+			sendConfig.MaxRetries = &maxRetries
+		}
+		_, err := rpc.SendTransaction(ctx, encoded, sendConfig)
+		return err
+	}
+	if err := send(false); err != nil {
+		return TransactionConfirmation{}, err
+	}
+
+	var rebroadcast func()
+	if config.rebroadcastInterval > 0 {
+		rebroadcast = func() {
+			//The transaction passed preflight already, and failed rebroadcasts are made up for by the next one
+			send(true)
+		}
+	}
+	return config.confirm(ctx, rpc, transaction.Signatures[0], lifetime, rebroadcast)
+}
+
+// Waits until the transaction with the signature reaches the commitment, or lifetime says it expired. The transaction is not rebroadcast.
+func ConfirmTransaction(ctx context.Context, rpc RpcContext, signature string, lifetime TransactionLifetime, opts ...ConfirmOption) (TransactionConfirmation, error) {
+	config := newConfirmConfig(opts)
+	return config.confirm(ctx, rpc, signature, lifetime, nil)
+}
+
+func (c confirmConfig) confirm(ctx context.Context, rpc RpcContext, signature string, lifetime TransactionLifetime, rebroadcast func()) (TransactionConfirmation, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	notified := make(chan TransactionConfirmation, 1)
+	if c.subscriber != nil {
+		go func() {
+			slot, txErr, err := c.subscriber.WaitForSignature(ctx, signature, c.commitment)
+			//Polling carries on if the subscription fails
+			if err == nil {
+				notified <- TransactionConfirmation{Signature: signature, Slot: slot, ConfirmationStatus: c.commitment, Err: txErr}
+			}
+		}()
+	}
+
+	poll := time.NewTicker(c.pollInterval)
+	defer poll.Stop()
+	var rebroadcastC <-chan time.Time
+	if rebroadcast != nil {
+		ticker := time.NewTicker(c.rebroadcastInterval)
+		defer ticker.Stop()
+		rebroadcastC = ticker.C
+	}
+
+	var lastErr error
+	for {
+		confirmation, confirmed, err := c.status(ctx, rpc, signature)
+		if confirmed {
+			return confirmation, confirmation.err()
+		}
+		if err == nil {
+			var expired bool
+			expired, err = lifetime.Expired(ctx, rpc, c.commitment)
+			if expired {
+				//The transaction may have landed between the status and the expiry checks
+				if confirmation, confirmed, _ := c.status(ctx, rpc, signature); confirmed {
+					return confirmation, confirmation.err()
+				}
+				return TransactionConfirmation{}, lifetime.ExpiredError()
+			}
+		}
+		if err != nil {
+			//Failed polls are retried on the next tick, and only reported if the context ends first
+			lastErr = err
+		}
+
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				return TransactionConfirmation{}, errors.Join(ctx.Err(), lastErr)
+			case confirmation := <-notified:
+				return confirmation, confirmation.err()
+			case <-rebroadcastC:
+				rebroadcast()
+			case <-poll.C:
+				break wait
+			}
+		}
+	}
+}
+
+// Returns the status of the transaction, and whether it reached the commitment.
+func (c confirmConfig) status(ctx context.Context, rpc RpcContext, signature string) (TransactionConfirmation, bool, error) {
+	statuses, err := rpc.GetSignatureStatuses(ctx, []string{signature})
+	if err != nil {
+		return TransactionConfirmation{}, false, err
+	}
+	if len(statuses) == 0 || statuses[0] == nil {
+		return TransactionConfirmation{}, false, nil
+	}
+	status := statuses[0]
+	reached := status.commitment()
+	confirmation := TransactionConfirmation{Signature: signature, Slot: status.Slot, ConfirmationStatus: reached, Err: status.Err}
+	return confirmation, commitmentRank(reached) >= commitmentRank(c.commitment), nil
+}
+
+// Returns the commitment the transaction reached. Nodes not reporting it count the confirmations instead, which are null once the block is rooted.
+func (s SignatureStatus) commitment() Commitment {
+	switch {
+	case s.ConfirmationStatus != nil:
+		return *s.ConfirmationStatus
+	case s.Confirmations == nil:
+		return CommitmentFinalized
+	}
+	return CommitmentProcessed
+}
+
+func commitmentRank(commitment Commitment) int {
+	switch commitment {
+	case CommitmentConfirmed:
+		return 1
+	case CommitmentFinalized:
+		return 2
+	}
+	return 0
+}
+
+// Returns the TransactionError of a failed transaction, nil if it succeeded.
+func (c TransactionConfirmation) err() error {
+	if c.Err == nil {
+		return nil
+	}
+	return c.Err
+}
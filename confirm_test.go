@@ -0,0 +1,128 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Answers the calls made while confirming a transaction. The transaction lands once it was sent landAfter times, and reaches each commitment one poll later.
+type confirmTestRpc struct {
+	RpcContext
+
+	mu          sync.Mutex
+	landAfter   int
+	sends       int
+	polls       int
+	landedAt    int //Poll the transaction landed at, zero until it lands
+	txErr       *TransactionError
+	blockHeight uint
+}
+
+func (r *confirmTestRpc) SendTransaction(ctx context.Context, transaction string, config ...SendTransactionConfig) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sends++
+	return "", nil
+}
+
+func (r *confirmTestRpc) GetSignatureStatuses(ctx context.Context, signatures []string, config ...GetSignatureStatusesConfig) ([]*SignatureStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.polls++
+	if r.landAfter == 0 || r.sends < r.landAfter {
+		return []*SignatureStatus{nil}, nil
+	}
+	if r.landedAt == 0 {
+		r.landedAt = r.polls
+	}
+	commitments := []Commitment{CommitmentProcessed, CommitmentConfirmed, CommitmentFinalized}
+	commitment := commitments[min(r.polls-r.landedAt, len(commitments)-1)]
+	confirmations := uint(1)
+	return []*SignatureStatus{{Slot: 42, Confirmations: &confirmations, ConfirmationStatus: &commitment, Err: r.txErr}}, nil
+}
+
+func (r *confirmTestRpc) GetBlockHeight(ctx context.Context, config ...StandardRpcConfig) (uint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blockHeight++
+	return r.blockHeight, nil
+}
+
+func newConfirmTestTransaction(t *testing.T) RawTransaction {
+	keypair := newTestKeypair(t, 1)
+	tx := Transaction{
+		Message: Message{
+			Instructions:    []Instruction{SystemProgramInstructions().Transfer(keypair.Pubkey, newTestKeypair(t, 2).Pubkey, 1)},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	if err := tx.Sign(keypair); err != nil {
+		t.Fatal(err)
+	}
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rawTx
+}
+
+func TestSendAndConfirmTransaction(t *testing.T) {
+	rpc := &confirmTestRpc{landAfter: 3}
+	rawTx := newConfirmTestTransaction(t)
+	confirmation, err := SendAndConfirmTransaction(context.Background(), rpc, rawTx, BlockhashLifetime{LastValidBlockHeight: 1000},
+		WithConfirmCommitment(CommitmentConfirmed), WithConfirmPollInterval(5*time.Millisecond), WithRebroadcastInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confirmation.Signature != rawTx.Signatures[0] || confirmation.Slot != 42 || confirmation.ConfirmationStatus != CommitmentConfirmed {
+		t.Fatal("Unexpected confirmation", confirmation)
+	}
+	if rpc.sends < 3 {
+		t.Fatal("Expected the transaction to be rebroadcast, sent", rpc.sends)
+	}
+}
+
+func TestConfirmTransactionError(t *testing.T) {
+	rpc := &confirmTestRpc{landAfter: 1, txErr: &TransactionError{Kind: "InsufficientFundsForFee"}}
+	rawTx := newConfirmTestTransaction(t)
+	confirmation, err := SendAndConfirmTransaction(context.Background(), rpc, rawTx, BlockhashLifetime{LastValidBlockHeight: 1000}, WithConfirmPollInterval(time.Millisecond))
+	if !errors.Is(err, ErrTransactionInsufficientFundsForFee) {
+		t.Fatal("Expected the transaction error, got", err)
+	}
+	if confirmation.ConfirmationStatus != CommitmentFinalized || !errors.Is(confirmation.Err, ErrTransactionInsufficientFundsForFee) {
+		t.Fatal("Unexpected confirmation", confirmation)
+	}
+}
+
+func TestConfirmTransactionExpired(t *testing.T) {
+	rpc := &confirmTestRpc{}
+	_, err := ConfirmTransaction(context.Background(), rpc, "sig", BlockhashLifetime{LastValidBlockHeight: 3}, WithConfirmPollInterval(time.Millisecond))
+	if !errors.Is(err, ErrBlockhashExpired) {
+		t.Fatal("Expected the blockhash to expire, got", err)
+	}
+	if rpc.blockHeight != 4 {
+		t.Fatal("Expected to stop once the block height passed the last valid height, got", rpc.blockHeight)
+	}
+}
+
+type testSignatureSubscriber struct{}
+
+func (testSignatureSubscriber) WaitForSignature(ctx context.Context, signature string, commitment Commitment) (uint, *TransactionError, error) {
+	return 7, nil, nil
+}
+
+func TestConfirmTransactionSubscriber(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	confirmation, err := ConfirmTransaction(ctx, &confirmTestRpc{}, "sig", BlockhashLifetime{LastValidBlockHeight: 1 << 30},
+		WithConfirmPollInterval(time.Hour), WithSignatureSubscriber(testSignatureSubscriber{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confirmation.Slot != 7 || confirmation.ConfirmationStatus != CommitmentFinalized {
+		t.Fatal("Unexpected confirmation", confirmation)
+	}
+}
@@ -0,0 +1,108 @@
+package solana
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// Lamports charged per signature by the clusters, unless they change their fee rate governor.
+const DefaultLamportsPerSignature = 5000
+
+const (
+	defaultInstructionComputeUnits = 200_000 //Compute unit limit granted per instruction when a transaction does not set one
+	microLamportsPerLamport        = 1_000_000
+)
+
+// Fee charged to the fee payer of a transaction.
+type FeeEstimate struct {
+	SignatureFee uint //Fee for the transaction's signatures and the signatures verified by precompiled programs
+	PriorityFee  uint //Compute unit price times compute unit limit, in lamports rounded up
+}
+
+// Returns the whole fee the fee payer is charged.
+func (f FeeEstimate) Total() uint {
+	return f.SignatureFee + f.PriorityFee
+}
+
+// Returns the fee of the message, with the signature fee from GetFeeForMessage and the priority fee from its Compute Budget Program instructions.
+// Nodes differ in whether GetFeeForMessage includes the priority fee, so the node is asked for the fee of the message without its compute unit price.
+// The message must have a recent blockhash the node knows of, or ErrTransactionBlockhashNotFound is returned.
+func EstimateFee(ctx context.Context, rpc RpcContext, message Message, commitment Commitment) (FeeEstimate, error) {
+	priorityFee := message.priorityFee()
+	message.Instructions = slices.DeleteFunc(slices.Clone(message.Instructions), func(instruction Instruction) bool {
+		return instruction.ProgramID != nil && instruction.ProgramID.String() == ComputeBudgetProgram.String() &&
+			len(instruction.Data) > 0 && instruction.Data[0] == computeBudgetSetComputeUnitPrice
+	})
+	rawMessage, err := compileMessage(message, nil)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	data, err := rawMessage.Bytes()
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	fee, err := rpc.GetFeeForMessage(ctx, data, StandardRpcConfig{Commitment: &commitment})
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	//The node has no fee for messages whose blockhash it does not know
+	if fee == nil {
+		return FeeEstimate{}, ErrTransactionBlockhashNotFound
+	}
+	return FeeEstimate{SignatureFee: *fee, PriorityFee: priorityFee}, nil
+}
+
+// Returns the fee of the message without asking a node: lamportsPerSignature for each signature the transaction holds or has precompiled programs verify, plus the priority fee from its Compute Budget Program instructions.
+// Use DefaultLamportsPerSignature, or the LamportsPerSignature of a NonceAccount.
+func CalculateFee(message Message, lamportsPerSignature uint) (FeeEstimate, error) {
+	rawMessage, err := compileMessage(message, nil)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	signatures := uint(rawMessage.Header.NumRequiredSignatures)
+	for _, instruction := range message.Instructions {
+		if instruction.ProgramID == nil || len(instruction.Data) == 0 {
+			continue
+		}
+		//Precompiled programs take the number of signatures they verify as their first byte
+		switch instruction.ProgramID.String() {
+		case Ed25519Program.String(), Secp256k1Program.String(), Secp256r1Program.String():
+			signatures += uint(instruction.Data[0])
+		}
+	}
+	return FeeEstimate{SignatureFee: signatures * lamportsPerSignature, PriorityFee: message.priorityFee()}, nil
+}
+
+// Returns the compute unit price times the compute unit limit set by the message's instructions, in lamports rounded up.
+// Without a SetComputeUnitLimit instruction, the limit is the default granted to each instruction other than the Compute Budget Program's.
+func (m Message) priorityFee() uint {
+	data, ok := m.computeBudgetInstruction(computeBudgetSetComputeUnitPrice)
+	if !ok || len(data) < 8 {
+		return 0
+	}
+	price := binary.LittleEndian.Uint64(data)
+
+	var limit uint64
+	if data, ok := m.computeBudgetInstruction(computeBudgetSetComputeUnitLimit); ok && len(data) >= 4 {
+		limit = uint64(binary.LittleEndian.Uint32(data))
+	} else {
+		for _, instruction := range m.Instructions {
+			if instruction.ProgramID == nil || instruction.ProgramID.String() != ComputeBudgetProgram.String() {
+				limit += defaultInstructionComputeUnits
+			}
+		}
+	}
+	limit = min(limit, MaxComputeUnitLimit)
+
+	//The product overflows 64 bits for high prices
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(price), new(big.Int).SetUint64(limit))
+	fee.Add(fee, big.NewInt(microLamportsPerLamport-1))
+	fee.Div(fee, big.NewInt(microLamportsPerLamport))
+	if !fee.IsUint64() || fee.Uint64() > math.MaxUint {
+		return math.MaxUint
+	}
+	return uint(fee.Uint64())
+}
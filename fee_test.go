@@ -0,0 +1,81 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCalculateFee(t *testing.T) {
+	payer := newTestKeypair(t, 1).Pubkey
+	transfer := SystemProgramInstructions().Transfer(payer, newTestKeypair(t, 2).Pubkey, 1)
+	budget := ComputeBudgetProgramInstructions()
+	tests := []struct {
+		name         string
+		instructions []Instruction
+		expected     FeeEstimate
+	}{
+		{"no priority fee", []Instruction{transfer}, FeeEstimate{SignatureFee: 5000}},
+		{"set limit", []Instruction{budget.SetComputeUnitLimit(300_000), budget.SetComputeUnitPrice(1000), transfer}, FeeEstimate{SignatureFee: 5000, PriorityFee: 300}},
+		{"default limit", []Instruction{budget.SetComputeUnitPrice(1000), transfer, transfer}, FeeEstimate{SignatureFee: 5000, PriorityFee: 400}},
+		{"rounded up", []Instruction{budget.SetComputeUnitLimit(1), budget.SetComputeUnitPrice(1), transfer}, FeeEstimate{SignatureFee: 5000, PriorityFee: 1}},
+		{"overflow", []Instruction{budget.SetComputeUnitLimit(MaxComputeUnitLimit), budget.SetComputeUnitPrice(math.MaxUint64), transfer}, FeeEstimate{SignatureFee: 5000, PriorityFee: math.MaxUint}},
+		{"precompile signatures", []Instruction{transfer, {ProgramID: Ed25519Program, Data: []byte{2, 0}}}, FeeEstimate{SignatureFee: 15000}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fee, err := CalculateFee(Message{Instructions: test.instructions}, DefaultLamportsPerSignature)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fee != test.expected {
+				t.Fatal("Expected", test.expected, "got", fee)
+			}
+		})
+	}
+	if fee := (FeeEstimate{SignatureFee: 5000, PriorityFee: 300}).Total(); fee != 5300 {
+		t.Fatal("Unexpected total", fee)
+	}
+}
+
+type feeTestRpc struct {
+	RpcContext
+	fee     *uint
+	message RawMessage
+}
+
+func (r *feeTestRpc) GetFeeForMessage(ctx context.Context, msg []byte, config ...StandardRpcConfig) (*uint, error) {
+	var err error
+	r.message, err = ParseMessageData(msg)
+	return r.fee, err
+}
+
+func TestEstimateFee(t *testing.T) {
+	payer := newTestKeypair(t, 1).Pubkey
+	message := Message{
+		Instructions: []Instruction{
+			ComputeBudgetProgramInstructions().SetComputeUnitLimit(10_000),
+			ComputeBudgetProgramInstructions().SetComputeUnitPrice(100_000),
+			SystemProgramInstructions().Transfer(payer, newTestKeypair(t, 2).Pubkey, 1),
+		},
+		RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+	}
+	nodeFee := uint(5000)
+	rpc := &feeTestRpc{fee: &nodeFee}
+	fee, err := EstimateFee(context.Background(), rpc, message, CommitmentConfirmed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee != (FeeEstimate{SignatureFee: 5000, PriorityFee: 1000}) {
+		t.Fatal("Unexpected fee", fee)
+	}
+	if len(rpc.message.Instructions) != 2 || rpc.message.AccountKeys[0].String() != payer.String() {
+		t.Fatal("Expected the node to be asked without the compute unit price", rpc.message.Instructions)
+	}
+
+	rpc.fee = nil
+	if _, err := EstimateFee(context.Background(), rpc, message, CommitmentConfirmed); !errors.Is(err, ErrTransactionBlockhashNotFound) {
+		t.Fatal("Expected the blockhash not to be found, got", err)
+	}
+}
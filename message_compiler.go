@@ -0,0 +1,230 @@
+package solana
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Errors compiling a message. Use them with errors.Is.
+var (
+	ErrFeePayerMissing           = NewSolanaError(SOLANA_ERROR__TRANSACTION__FEE_PAYER_MISSING, "transaction has no fee payer")
+	ErrAddressMissing            = NewSolanaError(SOLANA_ERROR__TRANSACTION__ADDRESS_MISSING, "instruction account or program ID is missing")
+	ErrInvokedProgramPaysFees    = NewSolanaError(SOLANA_ERROR__TRANSACTION__INVOKED_PROGRAMS_CANNOT_PAY_FEES, "invoked programs cannot pay fees")
+	ErrInvokedProgramWritable    = NewSolanaError(SOLANA_ERROR__TRANSACTION__INVOKED_PROGRAMS_MUST_NOT_BE_WRITABLE, "invoked programs must not be writable")
+	ErrAccountIndexOverflow      = NewSolanaError(SOLANA_ERROR__TRANSACTION_ERROR__TOO_MANY_ACCOUNT_LOCKS, "message references more than 256 accounts")
+	ErrLookupTableIndexOverflow  = NewSolanaError(SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ADDRESS_LOOKUP_TABLE_INDEX, "account is stored past index 255 of its address lookup table")
+	errUnsupportedMessageVersion = errors.New("unsupported message version")
+)
+
+// Maximum number of accounts a message can reference, as instructions index them with a single byte.
+const maxMessageAccounts = 256
+
+// An account of a message being compiled, with the union of its privileges across instructions.
+type compiledKey struct {
+	pubkey   Pubkey
+	signer   bool
+	writable bool
+	invoked  bool //Whether the account is the program ID of an instruction
+}
+
+// Returns the position of the key's group in the account keys: writable signers, readonly signers, writable non-signers, then readonly non-signers.
+func (k *compiledKey) group() int {
+	switch {
+	case k.signer && k.writable:
+		return 0
+	case k.signer:
+		return 1
+	case k.writable:
+		return 2
+	}
+	return 3
+}
+
+func compileMessage(msg Message, lookupTables []AddressLookupTable) (RawMessage, error) {
+	version := msg.Version
+	if len(lookupTables) > 0 {
+		version = TransactionVersion0
+	}
+	if version != TransactionVersionLegacy && version != TransactionVersion0 {
+		return RawMessage{}, fmt.Errorf("%w %s", errUnsupportedMessageVersion, version)
+	}
+
+	keys, err := compileKeys(msg)
+	if err != nil {
+		return RawMessage{}, err
+	}
+
+	static := keys
+	var lookups []MessageAddressTableLookup
+	var loadedWritable, loadedReadonly []Pubkey
+	if version == TransactionVersion0 {
+		static, lookups, loadedWritable, loadedReadonly, err = extractTableLookups(keys, lookupTables)
+		if err != nil {
+			return RawMessage{}, err
+		}
+	}
+	if accounts := len(static) + len(loadedWritable) + len(loadedReadonly); accounts > maxMessageAccounts {
+		return RawMessage{}, errorWithContext(ErrAccountIndexOverflow, map[string]any{"accounts": accounts, "maxAccounts": maxMessageAccounts})
+	}
+
+	var header MessageHeader
+	accountKeys := make([]Pubkey, len(static))
+	for i, key := range static {
+		accountKeys[i] = key.pubkey
+		switch key.group() {
+		case 0:
+			header.NumRequiredSignatures++
+		case 1:
+			header.NumRequiredSignatures++
+			header.NumReadonlySignedAccounts++
+		case 3:
+			header.NumReadonlyUnsignedAccounts++
+		}
+	}
+
+	//Instructions index the static keys, then the writable and the readonly loaded addresses
+	indexes := map[string]int{}
+	for i, pubkey := range slices.Concat(accountKeys, loadedWritable, loadedReadonly) {
+		indexes[string(pubkey.Bytes())] = i
+	}
+	instructions := make([]RawInstruction, len(msg.Instructions))
+	for i, instruction := range msg.Instructions {
+		accounts := make([]int, len(instruction.Accounts))
+		for j, account := range instruction.Accounts {
+			accounts[j] = indexes[string(account.Pubkey.Bytes())]
+		}
+		instructions[i] = RawInstruction{
+			Accounts:       accounts,
+			Data:           instruction.Data,
+			ProgramIDIndex: indexes[string(instruction.ProgramID.Bytes())],
+		}
+	}
+
+	return RawMessage{
+		Version:             version,
+		AccountKeys:         accountKeys,
+		Header:              header,
+		Instructions:        instructions,
+		RecentBlockhash:     msg.RecentBlockhash,
+		AddressTableLookups: lookups,
+	}, nil
+}
+
+// Returns every account the message references once, fee payer first, then ordered by group and pubkey bytes.
+func compileKeys(msg Message) ([]*compiledKey, error) {
+	feePayer := msg.FeePayer
+	for _, instruction := range msg.Instructions {
+		for _, account := range instruction.Accounts {
+			if feePayer == nil && account.Signer && account.Pubkey != nil {
+				feePayer = account.Pubkey
+			}
+		}
+	}
+	if feePayer == nil {
+		return nil, ErrFeePayerMissing
+	}
+
+	var keys []*compiledKey
+	byPubkey := map[string]*compiledKey{}
+	key := func(pubkey Pubkey) *compiledKey {
+		if k, ok := byPubkey[string(pubkey.Bytes())]; ok {
+			return k
+		}
+		k := &compiledKey{pubkey: pubkey}
+		byPubkey[string(pubkey.Bytes())] = k
+		keys = append(keys, k)
+		return k
+	}
+
+	payer := key(feePayer)
+	payer.signer, payer.writable = true, true
+	for i, instruction := range msg.Instructions {
+		if instruction.ProgramID == nil {
+			return nil, errorWithContext(ErrAddressMissing, map[string]any{"instruction": i})
+		}
+		key(instruction.ProgramID).invoked = true
+		for j, account := range instruction.Accounts {
+			if account.Pubkey == nil {
+				return nil, errorWithContext(ErrAddressMissing, map[string]any{"instruction": i, "account": j})
+			}
+			k := key(account.Pubkey)
+			k.signer = k.signer || account.Signer
+			k.writable = k.writable || account.Writable
+		}
+	}
+
+	for _, k := range keys {
+		if !k.invoked {
+			continue
+		}
+		if k == payer {
+			return nil, errorWithContext(ErrInvokedProgramPaysFees, map[string]any{"address": k.pubkey.String()})
+		}
+		if k.writable {
+			return nil, errorWithContext(ErrInvokedProgramWritable, map[string]any{"address": k.pubkey.String()})
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b *compiledKey) int {
+		if (a == payer) != (b == payer) {
+			if a == payer {
+				return -1
+			}
+			return 1
+		}
+		if a.group() != b.group() {
+			return a.group() - b.group()
+		}
+		return bytes.Compare(a.pubkey.Bytes(), b.pubkey.Bytes())
+	})
+	return keys, nil
+}
+
+// Moves the non-signer accounts found in the lookup tables out of the static keys. Each account is loaded from the first table holding it, and tables holding none of the accounts are left out.
+func extractTableLookups(keys []*compiledKey, lookupTables []AddressLookupTable) ([]*compiledKey, []MessageAddressTableLookup, []Pubkey, []Pubkey, error) {
+	var lookups []MessageAddressTableLookup
+	var loadedWritable, loadedReadonly []Pubkey
+	loaded := map[*compiledKey]bool{}
+	for _, table := range lookupTables {
+		lookup := MessageAddressTableLookup{AccountKey: table.Key}
+		for _, key := range keys {
+			if key.signer || key.invoked || loaded[key] {
+				continue
+			}
+			index := slices.IndexFunc(table.Addresses, func(address Pubkey) bool {
+				return bytes.Equal(address.Bytes(), key.pubkey.Bytes())
+			})
+			if index < 0 {
+				continue
+			}
+			if index >= maxMessageAccounts {
+				return nil, nil, nil, nil, errorWithContext(ErrLookupTableIndexOverflow, map[string]any{"address": key.pubkey.String(), "lookupTable": table.Key.String(), "index": index})
+			}
+			loaded[key] = true
+			if key.writable {
+				lookup.WritableIndexes = append(lookup.WritableIndexes, index)
+				loadedWritable = append(loadedWritable, key.pubkey)
+			} else {
+				lookup.ReadonlyIndexes = append(lookup.ReadonlyIndexes, index)
+				loadedReadonly = append(loadedReadonly, key.pubkey)
+			}
+		}
+		if len(lookup.WritableIndexes) > 0 || len(lookup.ReadonlyIndexes) > 0 {
+			lookups = append(lookups, lookup)
+		}
+	}
+
+	static := slices.DeleteFunc(slices.Clone(keys), func(key *compiledKey) bool {
+		return loaded[key]
+	})
+	return static, lookups, loadedWritable, loadedReadonly, nil
+}
+
+// Returns a copy of the sentinel error with details about the circumstances of the error.
+func errorWithContext(sentinel *SolanaError, context map[string]any) error {
+	err := *sentinel
+	err.Context = context
+	return &err
+}
@@ -0,0 +1,171 @@
+package solana
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCompileMessage(t *testing.T) {
+	payer := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	signer := MustParsePubkey("GR16g49y2fEjRQD612ryaXjNomRF2TCWoiMgspKtXqya")
+	account := MustParsePubkey("2u83Dx5qPV4QnujjJQv8v2SoqG1ixuAxPK5Jwhtkovd1")
+	program := MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	msg := Message{
+		Instructions: []Instruction{
+			{
+				Accounts: []AccountMeta{
+					{Pubkey: account},
+					{Pubkey: signer, Signer: true},
+					{Pubkey: payer, Signer: true},
+				},
+				ProgramID: program,
+			},
+			{
+				//The same accounts with more privileges, which are merged into the first ones
+				Accounts: []AccountMeta{
+					{Pubkey: account, Writable: true},
+					{Pubkey: program},
+				},
+				ProgramID: program,
+			},
+		},
+		RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+	}
+
+	message, err := compileMessage(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, key := range message.AccountKeys {
+		keys = append(keys, key.String())
+	}
+	//The first signer pays the fees, even though it is not the first account
+	if slices.Compare(keys, []string{signer.String(), payer.String(), account.String(), program.String()}) != 0 {
+		t.Fatal("Unexpected account keys", keys)
+	}
+	if message.Header != (MessageHeader{NumRequiredSignatures: 2, NumReadonlySignedAccounts: 1, NumReadonlyUnsignedAccounts: 1}) {
+		t.Fatal("Unexpected header", message.Header)
+	}
+	if slices.Compare(message.Instructions[0].Accounts, []int{2, 0, 1}) != 0 || message.Instructions[0].ProgramIDIndex != 3 {
+		t.Fatal("Unexpected instruction", message.Instructions[0])
+	}
+
+	msg.FeePayer = payer
+	message, err = compileMessage(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.AccountKeys[0].String() != payer.String() || message.AccountKeys[1].String() != signer.String() {
+		t.Fatal("Expected the fee payer to come first", message.AccountKeys)
+	}
+	//The fee payer is always a writable signer
+	if message.Header.NumReadonlySignedAccounts != 1 {
+		t.Fatal("Unexpected header", message.Header)
+	}
+}
+
+func TestCompileMessageErrors(t *testing.T) {
+	payer := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	program := MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	tests := []struct {
+		name     string
+		msg      Message
+		expected error
+	}{
+		{
+			name:     "no fee payer",
+			msg:      Message{Instructions: []Instruction{{Accounts: []AccountMeta{{Pubkey: payer, Writable: true}}, ProgramID: program}}},
+			expected: ErrFeePayerMissing,
+		},
+		{
+			name:     "missing program",
+			msg:      Message{Instructions: []Instruction{{Accounts: []AccountMeta{{Pubkey: payer, Signer: true}}}}},
+			expected: ErrAddressMissing,
+		},
+		{
+			name:     "missing account",
+			msg:      Message{FeePayer: payer, Instructions: []Instruction{{Accounts: []AccountMeta{{}}, ProgramID: program}}},
+			expected: ErrAddressMissing,
+		},
+		{
+			name:     "program pays fees",
+			msg:      Message{FeePayer: program, Instructions: []Instruction{{ProgramID: program}}},
+			expected: ErrInvokedProgramPaysFees,
+		},
+		{
+			name:     "writable program",
+			msg:      Message{FeePayer: payer, Instructions: []Instruction{{Accounts: []AccountMeta{{Pubkey: program, Writable: true}}, ProgramID: program}}},
+			expected: ErrInvokedProgramWritable,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := compileMessage(test.msg, nil); !errors.Is(err, test.expected) {
+				t.Fatal("Expected", test.expected, "got", err)
+			}
+		})
+	}
+
+	var accounts []AccountMeta
+	for i := 0; i < maxMessageAccounts; i++ {
+		var key [32]byte
+		key[0], key[1] = byte(i), 1
+		pubkey, err := ParsePubkeyBytes(key[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		accounts = append(accounts, AccountMeta{Pubkey: pubkey})
+	}
+	msg := Message{FeePayer: payer, Instructions: []Instruction{{Accounts: accounts, ProgramID: program}}}
+	_, err := compileMessage(msg, nil)
+	var solanaErr *SolanaError
+	if !errors.Is(err, ErrAccountIndexOverflow) || !errors.As(err, &solanaErr) || solanaErr.Code != SOLANA_ERROR__TRANSACTION_ERROR__TOO_MANY_ACCOUNT_LOCKS || solanaErr.Context["accounts"] != maxMessageAccounts+2 {
+		t.Fatal("Expected too many accounts to fail, got", err)
+	}
+
+	//An account past index 255 of a lookup table cannot be loaded from it
+	var key [32]byte
+	key[1] = 2
+	far, err := ParsePubkeyBytes(key[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := AddressLookupTable{Key: VoteProgram}
+	for _, account := range accounts {
+		table.Addresses = append(table.Addresses, account.Pubkey)
+	}
+	table.Addresses = append(table.Addresses, far)
+	msg = Message{FeePayer: payer, Instructions: []Instruction{{Accounts: []AccountMeta{{Pubkey: far}}, ProgramID: program}}}
+	_, err = compileMessage(msg, []AddressLookupTable{table})
+	if !errors.Is(err, ErrLookupTableIndexOverflow) || !errors.As(err, &solanaErr) || solanaErr.Code != SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ADDRESS_LOOKUP_TABLE_INDEX || solanaErr.Context["index"] != maxMessageAccounts {
+		t.Fatal("Expected the lookup table index to overflow, got", err)
+	}
+}
+
+// Compiling a transaction decoded from a message compiled by the Rust SDK gives back the same bytes.
+func TestCompileMessageRoundTrip(t *testing.T) {
+	data := []byte{1, 225, 123, 186, 177, 227, 37, 173, 118, 175, 177, 33, 183, 32, 42, 221, 1, 221, 157, 125, 95, 166, 203, 158, 122, 199, 20, 33, 150, 13, 137, 230, 73, 214, 249, 133, 58, 117, 149, 7, 172, 31, 82, 244, 204, 194, 174, 239, 106, 22, 110, 149, 21, 69, 251, 78, 198, 149, 210, 164, 166, 175, 5, 89, 14, 1, 0, 1, 3, 161, 68, 106, 177, 149, 255, 18, 122, 114, 25, 238, 115, 76, 66, 62, 224, 224, 53, 252, 245, 200, 239, 51, 242, 63, 210, 180, 118, 128, 206, 13, 23, 28, 53, 216, 230, 107, 42, 37, 88, 63, 140, 189, 215, 44, 74, 139, 105, 1, 234, 186, 80, 209, 29, 30, 151, 84, 217, 151, 212, 187, 30, 134, 92, 7, 97, 72, 29, 53, 116, 116, 187, 124, 77, 118, 36, 235, 211, 189, 179, 216, 53, 94, 115, 209, 16, 67, 252, 13, 163, 83, 128, 0, 0, 0, 0, 67, 38, 70, 223, 206, 169, 192, 25, 253, 85, 235, 130, 87, 163, 25, 137, 217, 2, 167, 14, 240, 241, 33, 120, 65, 63, 87, 91, 170, 240, 200, 39, 1, 2, 2, 1, 0, 116, 12, 0, 0, 0, 115, 73, 126, 20, 0, 0, 0, 0, 31, 1, 31, 1, 30, 1, 29, 1, 28, 1, 27, 1, 26, 1, 25, 1, 24, 1, 23, 1, 22, 1, 21, 1, 20, 1, 19, 1, 18, 1, 17, 1, 16, 1, 15, 1, 14, 1, 13, 1, 12, 1, 11, 1, 10, 1, 9, 1, 8, 1, 7, 1, 6, 1, 5, 1, 4, 1, 3, 1, 2, 1, 1, 23, 67, 38, 224, 121, 226, 210, 146, 191, 248, 41, 122, 192, 109, 199, 28, 87, 225, 33, 77, 211, 76, 139, 225, 161, 103, 27, 73, 101, 59, 71, 35, 1, 197, 178, 75, 103, 0, 0, 0, 0}
+
+	rawTx, err := ParseTransactionData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := rawTx.Transaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Message.FeePayer = nil
+	compiled, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	newData, err := compiled.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Compare(data, newData) != 0 {
+		t.Fatal("Data does not match")
+	}
+}
@@ -0,0 +1,109 @@
+package solana
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"github.com/mr-tron/base58"
+)
+
+// Bytes taken by a nonce account: version, state, authority, durable nonce and lamports per signature.
+const NonceAccountSize = 80
+
+const nonceStateInitialized = 1
+
+// Errors of durable nonce transactions. Use them with errors.Is.
+var (
+	ErrNonceInvalid         = NewSolanaError(SOLANA_ERROR__INVALID_NONCE, "nonce account no longer holds the transaction's nonce")
+	ErrNonceAccountNotFound = NewSolanaError(SOLANA_ERROR__NONCE_ACCOUNT_NOT_FOUND, "nonce account not found")
+)
+
+// State of an initialized nonce account.
+type NonceAccount struct {
+	Authority            Pubkey //Account allowed to advance the nonce, withdraw from the account and hand the authority over
+	Nonce                string //The durable nonce, used as the recent blockhash of a transaction advancing it
+	LamportsPerSignature uint   //Fee per signature when the nonce was stored
+}
+
+// Decodes the data of a nonce account.
+func ParseNonceAccount(data []byte) (NonceAccount, error) {
+	if len(data) < NonceAccountSize {
+		return NonceAccount{}, errors.New("not enough data to read nonce account")
+	}
+	//Data starts with the version of the nonce account layout, which does not change the layout of the state after it
+	if state := binary.LittleEndian.Uint32(data[4:8]); state != nonceStateInitialized {
+		return NonceAccount{}, errors.New("account is not an initialized nonce account")
+	}
+	authority, err := ParsePubkeyBytes(data[8:40])
+	if err != nil {
+		return NonceAccount{}, err
+	}
+	return NonceAccount{
+		Authority:            authority,
+		Nonce:                base58.Encode(data[40:72]),
+		LamportsPerSignature: uint(binary.LittleEndian.Uint64(data[72:80])),
+	}, nil
+}
+
+// Fetches and decodes the nonce account at address. Returns ErrNonceAccountNotFound if there is no account at address.
+func GetNonceAccount(ctx context.Context, rpc RpcContext, address Pubkey, commitment Commitment) (NonceAccount, error) {
+	account, err := rpc.GetAccountInfo(ctx, address, GetAccountInfoConfig{Commitment: &commitment})
+	if err != nil {
+		return NonceAccount{}, err
+	}
+	if account == nil {
+		return NonceAccount{}, ErrNonceAccountNotFound
+	}
+	if account.Owner == nil || account.Owner.String() != SystemProgram.String() {
+		return NonceAccount{}, errors.New("nonce account is not owned by the system program")
+	}
+	return ParseNonceAccount(account.Data)
+}
+
+// Returns a message using the nonce stored in nonceAccount as its recent blockhash, so it can be signed long before it is sent.
+// The instruction advancing the nonce is placed first, as the runtime requires. Its authority signs the transaction, and pays the fees unless the message's FeePayer is set.
+func NewNonceMessage(nonceAccount Pubkey, nonce NonceAccount, instructions ...Instruction) Message {
+	advance := SystemProgramInstructions().AdvanceNonceAccount(nonceAccount, nonce.Authority)
+	return Message{
+		Instructions:    append([]Instruction{advance}, instructions...),
+		RecentBlockhash: nonce.Nonce,
+	}
+}
+
+// Returns the nonce account advanced by the message, if its first instruction advances a nonce.
+func (m Message) nonceAccount() (Pubkey, bool) {
+	if len(m.Instructions) == 0 {
+		return nil, false
+	}
+	advance := m.Instructions[0]
+	if advance.ProgramID == nil || advance.ProgramID.String() != SystemProgram.String() || len(advance.Data) < 4 || len(advance.Accounts) < 3 {
+		return nil, false
+	}
+	if binary.LittleEndian.Uint32(advance.Data) != systemAdvanceNonceAccount {
+		return nil, false
+	}
+	return advance.Accounts[0].Pubkey, true
+}
+
+// Lifetime of a transaction using a durable nonce. The transaction can no longer land once the nonce account holds another nonce, which happens when the transaction itself or any other transaction advances it.
+type NonceLifetime struct {
+	NonceAccount Pubkey //Account storing the nonce
+	Nonce        string //The nonce used as the transaction's recent blockhash
+}
+
+func (l NonceLifetime) Expired(ctx context.Context, rpc RpcContext, commitment Commitment) (bool, error) {
+	nonce, err := GetNonceAccount(ctx, rpc, l.NonceAccount, commitment)
+	if errors.Is(err, ErrNonceAccountNotFound) {
+		//A closed nonce account cannot be advanced anymore
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return nonce.Nonce != l.Nonce, nil
+}
+
+func (l NonceLifetime) ExpiredError() error {
+	return ErrNonceInvalid
+}
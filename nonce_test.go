@@ -0,0 +1,114 @@
+package solana
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+// Returns the data of an initialized nonce account.
+func newNonceAccountData(authority Pubkey, nonce string) []byte {
+	data := make([]byte, NonceAccountSize)
+	binary.LittleEndian.PutUint32(data[0:4], 1)
+	binary.LittleEndian.PutUint32(data[4:8], nonceStateInitialized)
+	copy(data[8:40], authority.Bytes())
+	nonceData, _ := base58.Decode(nonce)
+	copy(data[40:72], nonceData)
+	binary.LittleEndian.PutUint64(data[72:80], 5000)
+	return data
+}
+
+func TestParseNonceAccount(t *testing.T) {
+	authority := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	nonce, err := ParseNonceAccount(newNonceAccountData(authority, "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce.Authority.String() != authority.String() || nonce.Nonce != "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa" || nonce.LamportsPerSignature != 5000 {
+		t.Fatal("Unexpected nonce account", nonce)
+	}
+
+	if _, err := ParseNonceAccount(make([]byte, NonceAccountSize)); err == nil {
+		t.Fatal("Expected an uninitialized nonce account to fail")
+	}
+	if _, err := ParseNonceAccount(make([]byte, 10)); err == nil {
+		t.Fatal("Expected short data to fail")
+	}
+}
+
+func TestNewNonceMessage(t *testing.T) {
+	authority := newTestKeypair(t, 1)
+	nonceAccount := newTestKeypair(t, 2).Pubkey
+	nonce := NonceAccount{Authority: authority.Pubkey, Nonce: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa"}
+	message := NewNonceMessage(nonceAccount, nonce, SystemProgramInstructions().Transfer(authority.Pubkey, newTestKeypair(t, 3).Pubkey, 1))
+	if message.RecentBlockhash != nonce.Nonce || len(message.Instructions) != 2 {
+		t.Fatal("Unexpected message", message)
+	}
+	if account, ok := message.nonceAccount(); !ok || account.String() != nonceAccount.String() {
+		t.Fatal("Expected the message to advance the nonce account")
+	}
+	if _, ok := (Message{Instructions: message.Instructions[1:]}).nonceAccount(); ok {
+		t.Fatal("Expected a transfer not to advance a nonce")
+	}
+
+	tx := Transaction{Message: message}
+	if err := tx.Sign(authority); err != nil {
+		t.Fatal(err)
+	}
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rawTx.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Answers getAccountInfo with a nonce account.
+type nonceTestRpc struct {
+	RpcContext
+	data []byte
+}
+
+func (r nonceTestRpc) GetAccountInfo(ctx context.Context, address Pubkey, config ...GetAccountInfoConfig) (*Account, error) {
+	if r.data == nil {
+		return nil, nil
+	}
+	return &Account{Address: address, Data: r.data, Owner: SystemProgram}, nil
+}
+
+func TestNonceLifetime(t *testing.T) {
+	authority := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	lifetime := NonceLifetime{NonceAccount: MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY"), Nonce: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa"}
+	ctx := context.Background()
+
+	expired, err := lifetime.Expired(ctx, nonceTestRpc{data: newNonceAccountData(authority, lifetime.Nonce)}, CommitmentConfirmed)
+	if err != nil || expired {
+		t.Fatal("Expected the nonce to be valid", err)
+	}
+	advanced := nonceTestRpc{data: newNonceAccountData(authority, "GHtXQBsoZHVnNFa9YevAzFr17DJjgHXk3ycTKD5xD3Zi")}
+	if expired, err := lifetime.Expired(ctx, advanced, CommitmentConfirmed); err != nil || !expired {
+		t.Fatal("Expected an advanced nonce to expire", err)
+	}
+	if expired, err := lifetime.Expired(ctx, nonceTestRpc{}, CommitmentConfirmed); err != nil || !expired {
+		t.Fatal("Expected a closed nonce account to expire", err)
+	}
+
+	_, err = ConfirmTransaction(ctx, &nonceConfirmTestRpc{confirmTestRpc: &confirmTestRpc{}, nonce: advanced}, "sig", lifetime, WithConfirmPollInterval(time.Millisecond))
+	if !errors.Is(err, ErrNonceInvalid) {
+		t.Fatal("Expected the nonce to be invalid, got", err)
+	}
+}
+
+type nonceConfirmTestRpc struct {
+	*confirmTestRpc
+	nonce nonceTestRpc
+}
+
+func (r *nonceConfirmTestRpc) GetAccountInfo(ctx context.Context, address Pubkey, config ...GetAccountInfoConfig) (*Account, error) {
+	return r.nonce.GetAccountInfo(ctx, address, config...)
+}
@@ -0,0 +1,86 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math"
+)
+
+const PDA_MARKER = "ProgramDerivedAddress"
+
+// Limits on the seeds of a program derived address, the bump seed included.
+const (
+	MaxSeeds      = 16
+	MaxSeedLength = 32 //Bytes in a seed, enough for a pubkey. Also the limit of a CreateWithSeed seed.
+)
+
+// Errors deriving addresses. Use them with errors.Is.
+var (
+	ErrMaxPdaSeedsExceeded      = NewSolanaError(SOLANA_ERROR__ADDRESSES__MAX_NUMBER_OF_PDA_SEEDS_EXCEEDED, "too many seeds, expected 16 or fewer")
+	ErrMaxPdaSeedLengthExceeded = NewSolanaError(SOLANA_ERROR__ADDRESSES__MAX_PDA_SEED_LENGTH_EXCEEDED, "seed is too long, expected 32 bytes or fewer")
+	ErrPdaOnCurve               = NewSolanaError(SOLANA_ERROR__ADDRESSES__INVALID_SEEDS_POINT_ON_CURVE, "seeds derive an address on the ed25519 curve")
+	ErrPdaBumpSeedNotFound      = NewSolanaError(SOLANA_ERROR__ADDRESSES__FAILED_TO_FIND_VIABLE_PDA_BUMP_SEED, "no bump seed derives an address off the ed25519 curve")
+	ErrPdaEndsWithPdaMarker     = NewSolanaError(SOLANA_ERROR__ADDRESSES__PDA_ENDS_WITH_PDA_MARKER, "owner ends with the program derived address marker")
+)
+
+// Returns the program derived address of programID for the seeds, as the runtime derives it. Returns ErrPdaOnCurve if the address would have a private key; FindProgramAddress finds seeds that do not.
+func CreateProgramAddress(seeds [][]byte, programID Pubkey) (Pubkey, error) {
+	if len(seeds) > MaxSeeds {
+		return nil, errorWithContext(ErrMaxPdaSeedsExceeded, map[string]any{"actual": len(seeds), "maxSeeds": MaxSeeds})
+	}
+	hash := sha256.New()
+	for i, seed := range seeds {
+		if len(seed) > MaxSeedLength {
+			return nil, errorWithContext(ErrMaxPdaSeedLengthExceeded, map[string]any{"index": i, "actual": len(seed), "maxSeedLength": MaxSeedLength})
+		}
+		hash.Write(seed)
+	}
+	hash.Write(programID.Bytes())
+	hash.Write([]byte(PDA_MARKER))
+	address := hash.Sum(nil)
+	if IsOnCurve(address) {
+		return nil, ErrPdaOnCurve
+	}
+	return ParsePubkeyBytes(address)
+}
+
+// Returns the canonical program derived address of programID for the seeds, and its bump seed: the highest one, appended to the seeds, deriving an address off the curve.
+func FindProgramAddress(seeds [][]byte, programID Pubkey) (Pubkey, uint8, error) {
+	//Copied so the bump seed is never appended to the caller's slice
+	candidate := append(append(make([][]byte, 0, len(seeds)+1), seeds...), nil)
+	for bump := math.MaxUint8; bump >= 0; bump-- {
+		candidate[len(seeds)] = []byte{byte(bump)}
+		address, err := CreateProgramAddress(candidate, programID)
+		if err == nil {
+			return address, uint8(bump), nil
+		}
+		if !errors.Is(err, ErrPdaOnCurve) {
+			return nil, 0, err
+		}
+	}
+	return nil, 0, ErrPdaBumpSeedNotFound
+}
+
+// Find a valid program address and its corresponding bump seed.
+//
+// Deprecated: Use FindProgramAddress.
+func Pda(seed [][]byte, programID Pubkey) (Pubkey, uint8, error) {
+	return FindProgramAddress(seed, programID)
+}
+
+// Returns the address derived from base, seed and owner, as created by the System Program's *WithSeed instructions. Unlike a program derived address, base signs for it.
+func CreateWithSeed(base Pubkey, seed string, owner Pubkey) (Pubkey, error) {
+	if len(seed) > MaxSeedLength {
+		return nil, errorWithContext(ErrMaxPdaSeedLengthExceeded, map[string]any{"actual": len(seed), "maxSeedLength": MaxSeedLength})
+	}
+	//The runtime refuses such owners, so seeded addresses never collide with program derived ones
+	if bytes.HasSuffix(owner.Bytes(), []byte(PDA_MARKER)) {
+		return nil, ErrPdaEndsWithPdaMarker
+	}
+	hash := sha256.New()
+	hash.Write(base.Bytes())
+	hash.Write([]byte(seed))
+	hash.Write(owner.Bytes())
+	return ParsePubkeyBytes(hash.Sum(nil))
+}
@@ -0,0 +1,90 @@
+package solana
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestCreateProgramAddress(t *testing.T) {
+	programID := MustParsePubkey("BPFLoader1111111111111111111111111111111111")
+	tests := []struct {
+		name     string
+		seeds    [][]byte
+		expected string
+	}{
+		{"empty seed and bump", [][]byte{{}, {1}}, "3gF2KMe9KiC6FNVBmfg9i267aMPvK37FewCip4eGBFcT"},
+		{"utf8 seed", [][]byte{[]byte("☉")}, "7ytmC1nT1xY4RfxCV2ZgyA7UakC93do5ZdyhdF3EtPj7"},
+		{"several seeds", [][]byte{[]byte("Talking"), []byte("Squirrels")}, "HwRVBufQ4haG5XSgpspwKtNd3PC9GM9m1196uJW36vds"},
+		{"pubkey seed", [][]byte{MustParsePubkey("SeedPubey1111111111111111111111111111111111").Bytes()}, "GUs5qLUfsEHkcMB9T38vjr18ypEhRuNWiePW2LoK4E3K"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, err := CreateProgramAddress(test.seeds, programID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address.String() != test.expected {
+				t.Fatal("Expected", test.expected, "got", address)
+			}
+		})
+	}
+
+	if _, err := CreateProgramAddress([][]byte{bytes.Repeat([]byte{1}, MaxSeedLength+1)}, programID); !errors.Is(err, ErrMaxPdaSeedLengthExceeded) {
+		t.Fatal("Expected a 33 byte seed to be too long, got", err)
+	}
+	if _, err := CreateProgramAddress(make([][]byte, MaxSeeds+1), programID); !errors.Is(err, ErrMaxPdaSeedsExceeded) {
+		t.Fatal("Expected 17 seeds to be too many, got", err)
+	}
+	if _, err := CreateProgramAddress([][]byte{bytes.Repeat([]byte{1}, MaxSeedLength)}, programID); err != nil && !errors.Is(err, ErrPdaOnCurve) {
+		t.Fatal("Expected a 32 byte seed to be accepted, got", err)
+	}
+}
+
+func TestFindProgramAddress(t *testing.T) {
+	programID := MustParsePubkey("BPFLoader1111111111111111111111111111111111")
+	seeds := make([][]byte, 1, 2)
+	seeds[0] = []byte("Lil'")
+
+	address, bump, err := FindProgramAddress(seeds, programID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := CreateProgramAddress([][]byte{[]byte("Lil'"), {bump}}, programID)
+	if err != nil || address.String() != expected.String() {
+		t.Fatal("Expected the address of the bump seed", expected, "got", address, err)
+	}
+	for higher := int(bump) + 1; higher <= 255; higher++ {
+		if _, err := CreateProgramAddress([][]byte{[]byte("Lil'"), {byte(higher)}}, programID); !errors.Is(err, ErrPdaOnCurve) {
+			t.Fatal("Expected bump", bump, "to be the canonical one, got", err)
+		}
+	}
+	if len(seeds) != 1 || len(seeds[:2][1]) != 0 {
+		t.Fatal("Expected the seeds to be left untouched", seeds[:2])
+	}
+
+	if _, _, err := FindProgramAddress(make([][]byte, MaxSeeds), programID); !errors.Is(err, ErrMaxPdaSeedsExceeded) {
+		t.Fatal("Expected no room for the bump seed, got", err)
+	}
+}
+
+func TestCreateWithSeed(t *testing.T) {
+	address, err := CreateWithSeed(SystemProgram, "limber chicken: 4/45", SystemProgram)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.String() != "9h1HyLCW5dZnBVap8C5egQ9Z6pHyjsh5MNy83iPqqRuq" {
+		t.Fatal("Unexpected address", address)
+	}
+
+	if _, err := CreateWithSeed(SystemProgram, string(bytes.Repeat([]byte{'a'}, MaxSeedLength+1)), SystemProgram); !errors.Is(err, ErrMaxPdaSeedLengthExceeded) {
+		t.Fatal("Expected a 33 byte seed to be too long, got", err)
+	}
+	owner, err := ParsePubkeyBytes(append(make([]byte, 32-len(PDA_MARKER)), PDA_MARKER...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CreateWithSeed(SystemProgram, "seed", owner); !errors.Is(err, ErrPdaEndsWithPdaMarker) {
+		t.Fatal("Expected an owner ending with the marker to be refused, got", err)
+	}
+}
@@ -1,6 +1,8 @@
 package solana
 
 import (
+	"encoding/binary"
+
 	"github.com/near/borsh-go"
 )
 
@@ -11,14 +13,58 @@ var (
 	StakeProgram              Pubkey = MustParsePubkey("Stake11111111111111111111111111111111111111") //Create and manage accounts representing stake and rewards for delegations to validators.
 	VoteProgram               Pubkey = MustParsePubkey("Vote111111111111111111111111111111111111111") //Create and manage accounts that track validator voting state and rewards.
 	AddressLookupTableProgram Pubkey = MustParsePubkey("AddressLookupTab1e1111111111111111111111111")
+	ComputeBudgetProgram      Pubkey = MustParsePubkey("ComputeBudget111111111111111111111111111111") //Sets the compute unit limit and price, heap size and loaded accounts data size of a transaction.
 	BpfLoaderProgram          Pubkey = MustParsePubkey("BPFLoaderUpgradeab1e11111111111111111111111") //Deploys, upgrades, and executes programs on the chain.
 	Ed25519Program            Pubkey = MustParsePubkey("Ed25519SigVerify111111111111111111111111111") //The program for verifying ed25519 signatures. It takes an ed25519 signature, a public key, and a message. Multiple signatures can be verified. If any of the signatures fail to verify, an error is returned.
 	Secp256k1Program          Pubkey = MustParsePubkey("KeccakSecp256k11111111111111111111111111111") //Verify secp256k1 public key recovery operations (ecrecover).
 	Secp256r1Program          Pubkey = MustParsePubkey("Secp256r1SigVerify1111111111111111111111111") //The program for verifying secp256r1 signatures. It takes a secp256r1 signature, a public key, and a message. Up to 8 signatures can be verified. If any of the signatures fail to verify, an error is returned.
 )
 
+var (
+	// Solana Program Library programs
+	TokenProgram           Pubkey = MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")  //Mints, transfers and burns fungible and non-fungible tokens. Its instructions and accounts are in the token package.
+	Token2022Program       Pubkey = MustParsePubkey("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")  //Token Program with extensions to its mints and token accounts, such as transfer fees and metadata. Its instructions and accounts are in the token package.
+	AssociatedTokenProgram Pubkey = MustParsePubkey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL") //Creates the token account of a wallet for a mint at an address derived from both. Its instructions are in the token package.
+)
+
+var (
+	// Sysvars
+	SysvarRecentBlockhashes Pubkey = MustParsePubkey("SysvarRecentB1ockHashes11111111111111111111") //Recent blockhashes, read by the nonce instructions of the System Program
+	SysvarRent              Pubkey = MustParsePubkey("SysvarRent111111111111111111111111111111111") //Rent parameters of the cluster
+)
+
+// Instruction discriminants of the System Program, encoded as a little endian u32.
+const (
+	systemCreateAccount          uint32 = 0
+	systemAssign                 uint32 = 1
+	systemTransfer               uint32 = 2
+	systemCreateAccountWithSeed  uint32 = 3
+	systemAdvanceNonceAccount    uint32 = 4
+	systemWithdrawNonceAccount   uint32 = 5
+	systemInitializeNonceAccount uint32 = 6
+	systemAuthorizeNonceAccount  uint32 = 7
+	systemAllocate               uint32 = 8
+	systemAllocateWithSeed       uint32 = 9
+	systemAssignWithSeed         uint32 = 10
+	systemTransferWithSeed       uint32 = 11
+	systemUpgradeNonceAccount    uint32 = 12
+)
+
 type SystemProgramIxs interface {
 	Transfer(source Pubkey, destination Pubkey, lamports uint) Instruction
+	CreateAccount(from Pubkey, newAccount Pubkey, lamports uint, space uint, owner Pubkey) Instruction                                   //Creates an account owned by owner, funded by from. Both accounts sign.
+	Assign(account Pubkey, owner Pubkey) Instruction                                                                                     //Hands the account over to the owner program. The account signs.
+	Allocate(account Pubkey, space uint) Instruction                                                                                     //Allocates space bytes of data to the account. The account signs.
+	CreateAccountWithSeed(from Pubkey, newAccount Pubkey, base Pubkey, seed string, lamports uint, space uint, owner Pubkey) Instruction //Creates the account derived from base, seed and owner, as CreateWithSeed returns, funded by from. from and base sign.
+	AllocateWithSeed(account Pubkey, base Pubkey, seed string, space uint, owner Pubkey) Instruction                                     //Allocates space bytes of data to the account derived from base, seed and owner, and assigns it to owner. base signs.
+	AssignWithSeed(account Pubkey, base Pubkey, seed string, owner Pubkey) Instruction                                                   //Assigns the account derived from base, seed and owner to owner. base signs.
+	TransferWithSeed(from Pubkey, base Pubkey, fromSeed string, fromOwner Pubkey, destination Pubkey, lamports uint) Instruction         //Transfers lamports from the account derived from base, fromSeed and fromOwner. base signs.
+	CreateNonceAccount(from Pubkey, nonceAccount Pubkey, authority Pubkey, lamports uint) []Instruction                                  //Creates and initializes a nonce account. lamports must cover the rent exemption of NonceAccountSize bytes.
+	InitializeNonceAccount(nonceAccount Pubkey, authority Pubkey) Instruction                                                            //Stores a durable nonce in a nonce account and sets the authority allowed to advance it
+	AdvanceNonceAccount(nonceAccount Pubkey, authority Pubkey) Instruction                                                               //Replaces the stored nonce with a new one. Must be the first instruction of a transaction using the nonce.
+	WithdrawNonceAccount(nonceAccount Pubkey, authority Pubkey, destination Pubkey, lamports uint) Instruction                           //Withdraws lamports from a nonce account. Withdrawing the whole balance closes it.
+	AuthorizeNonceAccount(nonceAccount Pubkey, authority Pubkey, newAuthority Pubkey) Instruction                                        //Hands the authority of a nonce account over to newAuthority
+	UpgradeNonceAccount(nonceAccount Pubkey) Instruction                                                                                 //Upgrades a nonce account created before durable nonces were domain separated from blockhashes
 }
 
 func SystemProgramInstructions() SystemProgramIxs {
@@ -32,7 +78,7 @@ func (systemProgramIxs) Transfer(source Pubkey, destination Pubkey, lamports uin
 		Instruction uint32
 		Lamports    uint64
 	}{
-		Instruction: 2,
+		Instruction: systemTransfer,
 		Lamports:    uint64(lamports),
 	})
 
@@ -42,3 +88,253 @@ func (systemProgramIxs) Transfer(source Pubkey, destination Pubkey, lamports uin
 		Accounts:  []AccountMeta{{Pubkey: source, Signer: true, Writable: true}, {Pubkey: destination, Signer: false, Writable: true}},
 	}
 }
+
+func (systemProgramIxs) CreateAccount(from Pubkey, newAccount Pubkey, lamports uint, space uint, owner Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Lamports    uint64
+		Space       uint64
+		Owner       [32]byte
+	}{
+		Instruction: systemCreateAccount,
+		Lamports:    uint64(lamports),
+		Space:       uint64(space),
+		Owner:       [32]byte(owner.Bytes()),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts:  []AccountMeta{{Pubkey: from, Signer: true, Writable: true}, {Pubkey: newAccount, Signer: true, Writable: true}},
+	}
+}
+
+func (systemProgramIxs) Assign(account Pubkey, owner Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Owner       [32]byte
+	}{
+		Instruction: systemAssign,
+		Owner:       [32]byte(owner.Bytes()),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts:  []AccountMeta{{Pubkey: account, Signer: true, Writable: true}},
+	}
+}
+
+func (systemProgramIxs) Allocate(account Pubkey, space uint) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Space       uint64
+	}{
+		Instruction: systemAllocate,
+		Space:       uint64(space),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts:  []AccountMeta{{Pubkey: account, Signer: true, Writable: true}},
+	}
+}
+
+func (systemProgramIxs) CreateAccountWithSeed(from Pubkey, newAccount Pubkey, base Pubkey, seed string, lamports uint, space uint, owner Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Base        [32]byte
+	}{
+		Instruction: systemCreateAccountWithSeed,
+		Base:        [32]byte(base.Bytes()),
+	})
+	data = appendBincodeString(data, seed)
+	fields, _ := borsh.Serialize(struct {
+		Lamports uint64
+		Space    uint64
+		Owner    [32]byte
+	}{
+		Lamports: uint64(lamports),
+		Space:    uint64(space),
+		Owner:    [32]byte(owner.Bytes()),
+	})
+
+	accounts := []AccountMeta{{Pubkey: from, Signer: true, Writable: true}, {Pubkey: newAccount, Writable: true}}
+	if base.String() != from.String() {
+		accounts = append(accounts, AccountMeta{Pubkey: base, Signer: true})
+	}
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      append(data, fields...),
+		Accounts:  accounts,
+	}
+}
+
+func (systemProgramIxs) AllocateWithSeed(account Pubkey, base Pubkey, seed string, space uint, owner Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Base        [32]byte
+	}{
+		Instruction: systemAllocateWithSeed,
+		Base:        [32]byte(base.Bytes()),
+	})
+	data = appendBincodeString(data, seed)
+	fields, _ := borsh.Serialize(struct {
+		Space uint64
+		Owner [32]byte
+	}{
+		Space: uint64(space),
+		Owner: [32]byte(owner.Bytes()),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      append(data, fields...),
+		Accounts:  []AccountMeta{{Pubkey: account, Writable: true}, {Pubkey: base, Signer: true}},
+	}
+}
+
+func (systemProgramIxs) AssignWithSeed(account Pubkey, base Pubkey, seed string, owner Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Base        [32]byte
+	}{
+		Instruction: systemAssignWithSeed,
+		Base:        [32]byte(base.Bytes()),
+	})
+	data = appendBincodeString(data, seed)
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      append(data, owner.Bytes()...),
+		Accounts:  []AccountMeta{{Pubkey: account, Writable: true}, {Pubkey: base, Signer: true}},
+	}
+}
+
+func (systemProgramIxs) TransferWithSeed(from Pubkey, base Pubkey, fromSeed string, fromOwner Pubkey, destination Pubkey, lamports uint) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Lamports    uint64
+	}{
+		Instruction: systemTransferWithSeed,
+		Lamports:    uint64(lamports),
+	})
+	data = appendBincodeString(data, fromSeed)
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      append(data, fromOwner.Bytes()...),
+		Accounts: []AccountMeta{
+			{Pubkey: from, Writable: true},
+			{Pubkey: base, Signer: true},
+			{Pubkey: destination, Writable: true},
+		},
+	}
+}
+
+func (s systemProgramIxs) CreateNonceAccount(from Pubkey, nonceAccount Pubkey, authority Pubkey, lamports uint) []Instruction {
+	return []Instruction{
+		s.CreateAccount(from, nonceAccount, lamports, NonceAccountSize, SystemProgram),
+		s.InitializeNonceAccount(nonceAccount, authority),
+	}
+}
+
+func (systemProgramIxs) InitializeNonceAccount(nonceAccount Pubkey, authority Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Authority   [32]byte
+	}{
+		Instruction: systemInitializeNonceAccount,
+		Authority:   [32]byte(authority.Bytes()),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts: []AccountMeta{
+			{Pubkey: nonceAccount, Writable: true},
+			{Pubkey: SysvarRecentBlockhashes},
+			{Pubkey: SysvarRent},
+		},
+	}
+}
+
+func (systemProgramIxs) AdvanceNonceAccount(nonceAccount Pubkey, authority Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+	}{
+		Instruction: systemAdvanceNonceAccount,
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts: []AccountMeta{
+			{Pubkey: nonceAccount, Writable: true},
+			{Pubkey: SysvarRecentBlockhashes},
+			{Pubkey: authority, Signer: true},
+		},
+	}
+}
+
+func (systemProgramIxs) WithdrawNonceAccount(nonceAccount Pubkey, authority Pubkey, destination Pubkey, lamports uint) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+		Lamports    uint64
+	}{
+		Instruction: systemWithdrawNonceAccount,
+		Lamports:    uint64(lamports),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts: []AccountMeta{
+			{Pubkey: nonceAccount, Writable: true},
+			{Pubkey: destination, Writable: true},
+			{Pubkey: SysvarRecentBlockhashes},
+			{Pubkey: SysvarRent},
+			{Pubkey: authority, Signer: true},
+		},
+	}
+}
+
+func (systemProgramIxs) AuthorizeNonceAccount(nonceAccount Pubkey, authority Pubkey, newAuthority Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction  uint32
+		NewAuthority [32]byte
+	}{
+		Instruction:  systemAuthorizeNonceAccount,
+		NewAuthority: [32]byte(newAuthority.Bytes()),
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts: []AccountMeta{
+			{Pubkey: nonceAccount, Writable: true},
+			{Pubkey: authority, Signer: true},
+		},
+	}
+}
+
+func (systemProgramIxs) UpgradeNonceAccount(nonceAccount Pubkey) Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint32
+	}{
+		Instruction: systemUpgradeNonceAccount,
+	})
+
+	return Instruction{
+		ProgramID: SystemProgram,
+		Data:      data,
+		Accounts:  []AccountMeta{{Pubkey: nonceAccount, Writable: true}},
+	}
+}
+
+// Appends a string the way bincode encodes it, prefixed by its length as a u64. The System Program is bincode encoded, and borsh prefixes strings with a u32 instead.
+func appendBincodeString(data []byte, s string) []byte {
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(s)))
+	return append(data, s...)
+}
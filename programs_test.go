@@ -1,6 +1,12 @@
 package solana
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
 
 func TestSystemProgramTransfer(t *testing.T) {
 	ix := SystemProgramInstructions().Transfer(
@@ -11,3 +17,114 @@ func TestSystemProgramTransfer(t *testing.T) {
 		t.Fatal("Unexpected data")
 	}
 }
+
+func TestSystemProgramNonceInstructions(t *testing.T) {
+	from := MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrQ")
+	nonce := MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY")
+	authority := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	ixs := SystemProgramInstructions()
+
+	create := ixs.CreateNonceAccount(from, nonce, authority, 1_447_680)
+	if len(create) != 2 || len(create[0].Data) != 52 || create[0].Data[0] != 0 || create[0].Data[12] != NonceAccountSize {
+		t.Fatal("Unexpected create account instruction", create)
+	}
+	if slices.Compare(create[1].Data, append([]byte{6, 0, 0, 0}, authority.Bytes()...)) != 0 || create[1].Accounts[1].Pubkey.String() != SysvarRecentBlockhashes.String() {
+		t.Fatal("Unexpected initialize instruction", create[1])
+	}
+
+	advance := ixs.AdvanceNonceAccount(nonce, authority)
+	if slices.Compare(advance.Data, []byte{4, 0, 0, 0}) != 0 || !advance.Accounts[0].Writable || !advance.Accounts[2].Signer {
+		t.Fatal("Unexpected advance instruction", advance)
+	}
+	withdraw := ixs.WithdrawNonceAccount(nonce, authority, from, 5)
+	if slices.Compare(withdraw.Data, []byte{5, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0}) != 0 || len(withdraw.Accounts) != 5 || withdraw.Accounts[4].Pubkey.String() != authority.String() {
+		t.Fatal("Unexpected withdraw instruction", withdraw)
+	}
+	authorize := ixs.AuthorizeNonceAccount(nonce, authority, from)
+	if slices.Compare(authorize.Data, append([]byte{7, 0, 0, 0}, from.Bytes()...)) != 0 || !authorize.Accounts[1].Signer {
+		t.Fatal("Unexpected authorize instruction", authorize)
+	}
+}
+
+func TestSystemProgramSeedInstructions(t *testing.T) {
+	from := MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrQ")
+	base := MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY")
+	account := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	ixs := SystemProgramInstructions()
+
+	create := ixs.CreateAccountWithSeed(from, account, base, "seed", 10, 20, StakeProgram)
+	expected := append([]byte{3, 0, 0, 0}, base.Bytes()...)
+	expected = append(expected, 4, 0, 0, 0, 0, 0, 0, 0, 's', 'e', 'e', 'd')
+	expected = append(expected, 10, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0)
+	expected = append(expected, StakeProgram.Bytes()...)
+	if slices.Compare(create.Data, expected) != 0 {
+		t.Fatal("Unexpected data", create.Data)
+	}
+	if len(create.Accounts) != 3 || !create.Accounts[2].Signer || create.Accounts[1].Signer {
+		t.Fatal("Expected the base to sign", create.Accounts)
+	}
+	if create := ixs.CreateAccountWithSeed(from, account, from, "seed", 10, 20, StakeProgram); len(create.Accounts) != 2 {
+		t.Fatal("Expected the funding account to sign as the base", create.Accounts)
+	}
+
+	transfer := ixs.TransferWithSeed(account, base, "seed", StakeProgram, from, 7)
+	expected = append([]byte{11, 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 's', 'e', 'e', 'd'}, StakeProgram.Bytes()...)
+	if slices.Compare(transfer.Data, expected) != 0 || !transfer.Accounts[1].Signer || transfer.Accounts[0].Signer {
+		t.Fatal("Unexpected transfer instruction", transfer)
+	}
+}
+
+func TestDecodeSystemInstruction(t *testing.T) {
+	a := MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrQ")
+	b := MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY")
+	c := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	ixs := SystemProgramInstructions()
+	tests := []struct {
+		instruction Instruction
+		expected    SystemInstruction
+	}{
+		{ixs.CreateAccount(a, b, 1, 2, c), SystemCreateAccount{From: a, NewAccount: b, Lamports: 1, Space: 2, Owner: c}},
+		{ixs.Assign(a, c), SystemAssign{Account: a, Owner: c}},
+		{ixs.Transfer(a, b, 3), SystemTransfer{Source: a, Destination: b, Lamports: 3}},
+		{ixs.CreateAccountWithSeed(a, b, c, "seed", 4, 5, a), SystemCreateAccountWithSeed{From: a, NewAccount: b, Base: c, Seed: "seed", Lamports: 4, Space: 5, Owner: a}},
+		{ixs.AdvanceNonceAccount(a, b), SystemAdvanceNonceAccount{NonceAccount: a, Authority: b}},
+		{ixs.WithdrawNonceAccount(a, b, c, 6), SystemWithdrawNonceAccount{NonceAccount: a, Destination: c, Authority: b, Lamports: 6}},
+		{ixs.InitializeNonceAccount(a, b), SystemInitializeNonceAccount{NonceAccount: a, Authority: b}},
+		{ixs.AuthorizeNonceAccount(a, b, c), SystemAuthorizeNonceAccount{NonceAccount: a, Authority: b, NewAuthority: c}},
+		{ixs.Allocate(a, 7), SystemAllocate{Account: a, Space: 7}},
+		{ixs.AllocateWithSeed(a, b, "", 8, c), SystemAllocateWithSeed{Account: a, Base: b, Space: 8, Owner: c}},
+		{ixs.AssignWithSeed(a, b, "seed", c), SystemAssignWithSeed{Account: a, Base: b, Seed: "seed", Owner: c}},
+		{ixs.TransferWithSeed(a, b, "seed", c, a, 9), SystemTransferWithSeed{Source: a, Base: b, Destination: a, Lamports: 9, SourceSeed: "seed", SourceOwner: c}},
+		{ixs.UpgradeNonceAccount(a), SystemUpgradeNonceAccount{NonceAccount: a}},
+	}
+	for _, test := range tests {
+		decoded, err := DecodeSystemInstruction(test.instruction)
+		if err != nil {
+			t.Fatal(err)
+		}
+		//Pubkeys are compared by their base58 strings
+		if fmt.Sprint(decoded) != fmt.Sprint(test.expected) || reflect.TypeOf(decoded) != reflect.TypeOf(test.expected) {
+			t.Fatal("Expected", test.expected, "got", decoded)
+		}
+	}
+
+	transfer := ixs.Transfer(a, b, 3)
+	initializeNonce := ixs.InitializeNonceAccount(a, b)
+	errorTests := []struct {
+		instruction Instruction
+		expected    error
+	}{
+		{Instruction{ProgramID: StakeProgram, Data: transfer.Data, Accounts: transfer.Accounts}, ErrInstructionProgramMismatch},
+		{Instruction{ProgramID: SystemProgram, Data: transfer.Data[:8], Accounts: transfer.Accounts}, ErrInstructionDataTooShort},
+		{Instruction{ProgramID: SystemProgram, Data: transfer.Data, Accounts: transfer.Accounts[:1]}, ErrInstructionMissingAccounts},
+		{Instruction{ProgramID: SystemProgram, Data: initializeNonce.Data, Accounts: initializeNonce.Accounts[:1]}, ErrInstructionMissingAccounts},
+		{Instruction{ProgramID: SystemProgram, Data: []byte{13, 0, 0, 0}}, ErrUnknownInstruction},
+		{Instruction{ProgramID: SystemProgram, Data: append([]byte{8, 0, 0, 0}, ixs.AssignWithSeed(a, b, "seed", c).Data[36:44]...), Accounts: transfer.Accounts}, nil},
+		{Instruction{ProgramID: SystemProgram, Data: ixs.AssignWithSeed(a, b, "seed", c).Data[:46], Accounts: transfer.Accounts}, ErrInstructionDataTooShort},
+	}
+	for i, test := range errorTests {
+		if _, err := DecodeSystemInstruction(test.instruction); !errors.Is(err, test.expected) {
+			t.Fatal(i, "Expected", test.expected, "got", err)
+		}
+	}
+}
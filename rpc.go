@@ -145,7 +145,7 @@ type GetBlockConfig struct {
 	Commitment                     *Commitment         `json:"commitment,omitempty"`                     //For preflight checks and transaction processing, Solana nodes choose which bank state to query based on a commitment requirement set by the client. The commitment describes how finalized a block is at that point in time. When querying the ledger state, it's recommended to use lower levels of commitment to report progress and higher levels to ensure the state will not be rolled back.
 	Encoding                       *Encoding           `json:"encoding,omitempty"`                       //Encoding format for each returned Transaction
 	TransactionDetails             *TransactionDetails `json:"transactionDetails,omitempty"`             //Level of transaction detail to return -- default is "full"
-	MaxSupportedTransactionVersion int                 `json:"maxSupportedTransactionVersion,omitempty"` //If this parameter is omitted, only legacy transactions will be returned, and a block containing any versioned transaction will prompt the error.
+	MaxSupportedTransactionVersion *int                `json:"maxSupportedTransactionVersion,omitempty"` //If this parameter is omitted, only legacy transactions will be returned, and a block containing any versioned transaction will prompt the error. RpcClient defaults it to 0.
 	Rewards                        bool                `json:"rewards,omitempty"`                        //Whether to populate the rewards array. If parameter not provided, the default includes rewards.
 }
 
@@ -200,7 +200,7 @@ type GetTokenAccountsByDelegateConfig struct {
 type GetTransactionSignatureConfig struct {
 	Commitment                     *Commitment `json:"commitment,omitempty"`                     //For preflight checks and transaction processing, Solana nodes choose which bank state to query based on a commitment requirement set by the client. The commitment describes how finalized a block is at that point in time. When querying the ledger state, it's recommended to use lower levels of commitment to report progress and higher levels to ensure the state will not be rolled back.
 	Encoding                       *Encoding   `json:"encoding,omitempty"`                       //Encoding format for each returned Transaction
-	MaxSupportedTransactionVersion int         `json:"maxSupportedTransactionVersion,omitempty"` //Set the max transaction version to return in responses. If the requested transaction is a higher version, an error will be returned. If this parameter is omitted, only legacy transactions will be returned, and any versioned transaction will prompt the error.
+	MaxSupportedTransactionVersion *int        `json:"maxSupportedTransactionVersion,omitempty"` //Set the max transaction version to return in responses. If the requested transaction is a higher version, an error will be returned. If this parameter is omitted, only legacy transactions will be returned, and any versioned transaction will prompt the error. RpcClient defaults it to 0.
 }
 
 type GetVoteAccountsConfig struct {
@@ -231,10 +231,10 @@ type SimulateTransactionConfig struct {
 }
 
 type SimulateTransactionResult struct {
-	Err           any       `json:"err"`           //Error if transaction failed, null if transaction succeeded.
-	Logs          []string  `json:"logs"`          //Array of string log messages or null if log message recording was not enabled during this transaction
-	Accounts      []Account `json:"accounts"`      //array of accounts with the same length as the accounts.addresses array in the request
-	UnitsConsumed *uint     `json:"unitsConsumed"` //The number of compute budget units consumed during the processing of this transaction
+	Err           *TransactionError `json:"err"`           //Error if transaction failed, null if transaction succeeded.
+	Logs          []string          `json:"logs"`          //Array of string log messages or null if log message recording was not enabled during this transaction
+	Accounts      []Account         `json:"accounts"`      //array of accounts with the same length as the accounts.addresses array in the request
+	UnitsConsumed *uint             `json:"unitsConsumed"` //The number of compute budget units consumed during the processing of this transaction
 	ReturnData    *struct {
 		ProgramID string `json:"programId"` //The program that generated the return data, as base-58 encoded Pubkey
 		Data      string `json:"data"`      //The return data, as base64 encoded string
@@ -347,18 +347,18 @@ type PrioritizationFee struct {
 type SignatureStatus struct {
 	Slot               uint              `json:"slot"`               //The slot in which the transaction was processed
 	Confirmations      *uint             `json:"confirmations"`      //Number of blocks since signature confirmation, null if rooted, as well as finalized by a supermajority of the cluster
-	Err                any               `json:"err"`                //Error if transaction failed
+	Err                *TransactionError `json:"err"`                //Error if transaction failed
 	ConfirmationStatus *Commitment       `json:"confirmationStatus"` //The transaction's cluster confirmation status
 	Status             TransactionStatus `json:"status"`             //Deprecated: Transaction status
 }
 
 type TransactionSignature struct {
-	Signature          string      `json:"signature"`          //The transaction signature, as base-58 encoded string
-	Slot               uint        `json:"slot"`               //The slot that contains the block with the transaction
-	Err                any         `json:"err"`                //Error if transaction failed
-	Memo               *string     `json:"memo"`               //Memo associated with the transaction, null if no memo is present
-	BlockTime          *int        `json:"blockTime"`          //Estimated production time, as Unix timestamp (seconds since the Unix epoch) of when transaction was processed. null if not available.
-	ConfirmationStatus *Commitment `json:"confirmationStatus"` //The transaction's cluster confirmation status
+	Signature          string            `json:"signature"`          //The transaction signature, as base-58 encoded string
+	Slot               uint              `json:"slot"`               //The slot that contains the block with the transaction
+	Err                *TransactionError `json:"err"`                //Error if transaction failed
+	Memo               *string           `json:"memo"`               //Memo associated with the transaction, null if no memo is present
+	BlockTime          *int              `json:"blockTime"`          //Estimated production time, as Unix timestamp (seconds since the Unix epoch) of when transaction was processed. null if not available.
+	ConfirmationStatus *Commitment       `json:"confirmationStatus"` //The transaction's cluster confirmation status
 }
 
 type Version struct {
@@ -407,8 +407,8 @@ type Account struct {
 
 // Deprecated: Transaction status
 type TransactionStatus struct {
-	Ok  any `json:"Ok"`
-	Err any `json:"Err"`
+	Ok  any               `json:"Ok"`
+	Err *TransactionError `json:"Err"`
 }
 
 type LatestBlockhash struct {
@@ -434,13 +434,13 @@ const (
 )
 
 type TransactionWithMeta struct {
-	Meta        *TransactionMeta `json:"meta"`
-	Version     *int             `json:"version"` //Transaction version. Undefined if maxSupportedTransactionVersion is not set in request params. --note can also be "legacy"
-	Transaction Transaction      `json:"transaction"`
+	Meta        *TransactionMeta    `json:"meta"`
+	Version     *TransactionVersion `json:"version"` //Transaction version, "legacy" or 0. Undefined if maxSupportedTransactionVersion is not set in request params.
+	Transaction Transaction         `json:"transaction"`
 }
 
 type TransactionMeta struct {
-	Err                  any                    `json:"err"`                  //Error if transaction failed, null if transaction succeeded. TransactionError definitions
+	Err                  *TransactionError      `json:"err"`                  //Error if transaction failed, null if transaction succeeded
 	Fee                  uint                   `json:"fee"`                  //Ree this transaction was charged, as u64 integer
 	InnerInstructions    []InnerInstructions    `json:"innerInstructions"`    //List of inner instructions or null if inner instruction recording was not enabled during this transaction
 	LogMessages          []string               `json:"logMessages"`          //Array of string log messages or null if log message recording was not enabled during this transaction
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -50,11 +51,23 @@ func (a *encodedAccount) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (r *RpcClient) GetAccountInfo(address solana.Pubkey, config ...solana.GetAccountInfoConfig) (*solana.Account, error) { //Returns all information associated with the account of provided Pubkey
+func (r *RpcClient) GetAccountInfo(ctx context.Context, address solana.Pubkey, config ...solana.GetAccountInfoConfig) (*solana.Account, error) { //Returns all information associated with the account of provided Pubkey
 	var res struct {
 		Value *encodedAccount `json:"value"`
 	}
 
+	if err := r.send(ctx, "getAccountInfo", accountInfoParams(address, config), &res); err != nil {
+		return nil, err
+	}
+	//If the account does not exist, return nil
+	if res.Value == nil {
+		return nil, nil
+	}
+
+	return res.Value.account(address), nil
+}
+
+func accountInfoParams(address solana.Pubkey, config []solana.GetAccountInfoConfig) []interface{} {
 	//Set the encoding to base64 no matter what
 	encoding := solana.EncodingBase64
 	params := []interface{}{address.String()}
@@ -64,26 +77,22 @@ func (r *RpcClient) GetAccountInfo(address solana.Pubkey, config ...solana.GetAc
 	} else {
 		params = append(params, solana.GetAccountInfoConfig{Encoding: &encoding})
 	}
-	if err := r.send("getAccountInfo", params, &res); err != nil {
-		return nil, err
-	}
-	//If the account does not exist, return nil
-	if res.Value == nil {
-		return nil, nil
-	}
+	return params
+}
 
+func (a *encodedAccount) account(address solana.Pubkey) *solana.Account {
 	return &solana.Account{
 		Address:    address,
-		Data:       res.Value.Data,
-		Executable: res.Value.Executable,
-		Lamports:   res.Value.Lamports,
-		Owner:      &res.Value.Owner,
-		RentEpoch:  res.Value.RentEpoch,
-		Space:      res.Value.Space,
-	}, nil
+		Data:       a.Data,
+		Executable: a.Executable,
+		Lamports:   a.Lamports,
+		Owner:      &a.Owner,
+		RentEpoch:  a.RentEpoch,
+		Space:      a.Space,
+	}
 }
 
-func (r *RpcClient) GetBalance(address solana.Pubkey, config ...solana.StandardRpcConfig) (uint, error) {
+func (r *RpcClient) GetBalance(ctx context.Context, address solana.Pubkey, config ...solana.StandardRpcConfig) (uint, error) {
 	var res struct {
 		Value uint `json:"value"`
 	}
@@ -92,14 +101,14 @@ func (r *RpcClient) GetBalance(address solana.Pubkey, config ...solana.StandardR
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getBalance", params, &res); err != nil {
+	if err := r.send(ctx, "getBalance", params, &res); err != nil {
 		return 0, err
 	}
 
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetLargestAccounts(config ...solana.GetLargestAccountsConfig) ([]solana.AccountWithBalance, error) {
+func (r *RpcClient) GetLargestAccounts(ctx context.Context, config ...solana.GetLargestAccountsConfig) ([]solana.AccountWithBalance, error) {
 	var res struct {
 		Value []solana.AccountWithBalance `json:"value"`
 	}
@@ -108,14 +117,14 @@ func (r *RpcClient) GetLargestAccounts(config ...solana.GetLargestAccountsConfig
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getLargestAccounts", params, &res); err != nil {
+	if err := r.send(ctx, "getLargestAccounts", params, &res); err != nil {
 		return nil, err
 	}
 
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetMinimumBalanceForRentExemption(accountDataLength uint, config ...solana.StandardCommitmentConfig) (uint, error) {
+func (r *RpcClient) GetMinimumBalanceForRentExemption(ctx context.Context, accountDataLength uint, config ...solana.StandardCommitmentConfig) (uint, error) {
 	var res uint
 	params := []interface{}{
 		accountDataLength,
@@ -123,14 +132,14 @@ func (r *RpcClient) GetMinimumBalanceForRentExemption(accountDataLength uint, co
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getMinimumBalanceForRentExemption", params, &res); err != nil {
+	if err := r.send(ctx, "getMinimumBalanceForRentExemption", params, &res); err != nil {
 		return 0, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetMultipleAccounts(pubkeys []solana.Pubkey, config ...solana.GetAccountInfoConfig) ([]*solana.Account, error) {
+func (r *RpcClient) GetMultipleAccounts(ctx context.Context, pubkeys []solana.Pubkey, config ...solana.GetAccountInfoConfig) ([]*solana.Account, error) {
 	var res struct {
 		Value []*encodedAccount `json:"value"`
 	}
@@ -144,7 +153,7 @@ func (r *RpcClient) GetMultipleAccounts(pubkeys []solana.Pubkey, config ...solan
 	} else {
 		params = append(params, solana.GetAccountInfoConfig{Encoding: &encoding})
 	}
-	if err := r.send("getMultipleAccounts", params, &res); err != nil {
+	if err := r.send(ctx, "getMultipleAccounts", params, &res); err != nil {
 		return nil, err
 	}
 	var accounts []*solana.Account
@@ -167,7 +176,7 @@ func (r *RpcClient) GetMultipleAccounts(pubkeys []solana.Pubkey, config ...solan
 	return accounts, nil
 }
 
-func (r *RpcClient) GetProgramAccounts(programPubkey solana.Pubkey, config ...solana.GetAccountInfoConfig) ([]solana.Account, error) {
+func (r *RpcClient) GetProgramAccounts(ctx context.Context, programPubkey solana.Pubkey, config ...solana.GetAccountInfoConfig) ([]solana.Account, error) {
 	var res []struct {
 		Account encodedAccount   `json:"account"`
 		Pubkey  solana.PubkeyStr `json:"pubkey"`
@@ -182,7 +191,7 @@ func (r *RpcClient) GetProgramAccounts(programPubkey solana.Pubkey, config ...so
 	} else {
 		params = append(params, solana.GetAccountInfoConfig{Encoding: &encoding})
 	}
-	if err := r.send("getProgramAccounts", params, &res); err != nil {
+	if err := r.send(ctx, "getProgramAccounts", params, &res); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,194 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Default maximum number of calls sent in a single JSON-RPC batch. Most providers reject batches above 100 requests.
+const DefaultMaxBatchSize = 100
+
+// A Batch queues RPC calls and sends them as JSON-RPC 2.0 batch requests. Each queued call returns a BatchResult which is populated once Send returns.
+// A Batch must not be used by several goroutines at once, but batches of the same client can be built and sent concurrently.
+type Batch struct {
+	MaxSize int //Maximum number of calls per HTTP request. Larger batches are split automatically.
+
+	client *RpcClient
+	calls  []*batchCall
+}
+
+type batchCall struct {
+	req     rpcReq
+	resolve func(result json.RawMessage, err error)
+}
+
+// The result of a single call queued on a Batch.
+type BatchResult[T any] struct {
+	value T
+	err   error
+	done  bool
+}
+
+// Returns the decoded value of the call, or the error returned for this call only. Calling Result before Batch.Send has returned yields an error.
+func (r *BatchResult[T]) Result() (T, error) {
+	if !r.done {
+		var zero T
+		return zero, errors.New("batch has not been sent")
+	}
+	return r.value, r.err
+}
+
+func (r *RpcClient) NewBatch() *Batch {
+	return &Batch{MaxSize: DefaultMaxBatchSize, client: r}
+}
+
+// Queues a call to any RPC method. The JSON result is decoded into T.
+func Queue[T any](b *Batch, method string, params any) *BatchResult[T] {
+	return queue(b, method, params, func(value T) (T, error) { return value, nil })
+}
+
+func queue[R, T any](b *Batch, method string, params any, convert func(R) (T, error)) *BatchResult[T] {
+	result := &BatchResult[T]{}
+	b.calls = append(b.calls, &batchCall{
+		req: b.client.newRequest(method, params),
+		resolve: func(raw json.RawMessage, err error) {
+			result.done = true
+			if err != nil {
+				result.err = err
+				return
+			}
+			var res R
+			if err := decodeResult(raw, &res); err != nil {
+				result.err = err
+				return
+			}
+			result.value, result.err = convert(res)
+		},
+	})
+	return result
+}
+
+// Returns the number of calls queued on the batch.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+func (b *Batch) GetAccountInfo(address solana.Pubkey, config ...solana.GetAccountInfoConfig) *BatchResult[*solana.Account] {
+	type result struct {
+		Value *encodedAccount `json:"value"`
+	}
+	return queue(b, "getAccountInfo", accountInfoParams(address, config), func(res result) (*solana.Account, error) {
+		if res.Value == nil {
+			return nil, nil
+		}
+		return res.Value.account(address), nil
+	})
+}
+
+func (b *Batch) GetBalance(address solana.Pubkey, config ...solana.StandardRpcConfig) *BatchResult[uint] {
+	type result struct {
+		Value uint `json:"value"`
+	}
+	params := []interface{}{address.String()}
+	if len(config) > 0 {
+		params = append(params, config[0])
+	}
+	return queue(b, "getBalance", params, func(res result) (uint, error) { return res.Value, nil })
+}
+
+func (b *Batch) GetSignatureStatuses(signatures []string, config ...solana.GetSignatureStatusesConfig) *BatchResult[[]*solana.SignatureStatus] {
+	type result struct {
+		Value []*solana.SignatureStatus `json:"value"`
+	}
+	params := []interface{}{signatures}
+	if len(config) > 0 {
+		params = append(params, config[0])
+	}
+	return queue(b, "getSignatureStatuses", params, func(res result) ([]*solana.SignatureStatus, error) { return res.Value, nil })
+}
+
+func (b *Batch) GetTokenAccountBalance(address solana.Pubkey, config ...solana.StandardCommitmentConfig) *BatchResult[solana.UiTokenAmount] {
+	type result struct {
+		Value solana.UiTokenAmount `json:"value"`
+	}
+	params := []interface{}{address.String()}
+	if len(config) > 0 {
+		params = append(params, config[0])
+	}
+	return queue(b, "getTokenAccountBalance", params, func(res result) (solana.UiTokenAmount, error) { return res.Value, nil })
+}
+
+// Sends every queued call, split into chunks of at most MaxSize calls. Errors returned by the node for a single call are reported through that call's BatchResult.
+// If a whole chunk fails (e.g. a transport error) every call in it receives the error, the remaining chunks are still sent and the first such error is returned.
+func (b *Batch) Send(ctx context.Context) error {
+	size := b.MaxSize
+	if size <= 0 {
+		size = DefaultMaxBatchSize
+	}
+
+	calls := b.calls
+	b.calls = nil
+
+	var firstErr error
+	for start := 0; start < len(calls); start += size {
+		end := min(start+size, len(calls))
+		if err := b.sendChunk(ctx, calls[start:end]); err != nil {
+			for _, call := range calls[start:end] {
+				call.resolve(nil, err)
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (b *Batch) sendChunk(ctx context.Context, calls []*batchCall) error {
+	reqs := make([]rpcReq, len(calls))
+	for i, call := range calls {
+		reqs[i] = call.req
+	}
+
+	resp, err := b.client.post(ctx, reqs)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return err
+	}
+
+	var results []rpcResp
+	if err := json.Unmarshal(raw, &results); err != nil {
+		//Nodes answer with a single error object when the batch as a whole is rejected
+		var result rpcResp
+		if err := json.Unmarshal(raw, &result); err == nil && result.Error != nil {
+			return newRpcError(result.Error, 0)
+		}
+		return fmt.Errorf("rpc batch request failed. Status code: %d", resp.StatusCode)
+	}
+
+	byID := make(map[int]rpcResp, len(results))
+	for _, result := range results {
+		byID[result.ID] = result
+	}
+	for _, call := range calls {
+		result, ok := byID[call.req.ID]
+		switch {
+		case !ok:
+			call.resolve(nil, fmt.Errorf("no response for request id %d", call.req.ID))
+		case result.Error != nil:
+			call.resolve(nil, newRpcError(result.Error, 0))
+		default:
+			call.resolve(result.Result, nil)
+		}
+	}
+	return nil
+}
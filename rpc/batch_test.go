@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Answers every call of a batch in reverse order. getBalance returns the request id as the balance and getTokenAccountBalance always fails.
+func newBatchServer(t *testing.T, posts *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*posts++
+		var reqs []rpcReq
+		if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+			t.Error(err)
+			return
+		}
+		var results []string
+		for _, req := range reqs {
+			switch req.Method {
+			case "getBalance":
+				results = append(results, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":{"context":{"slot":1},"value":%d}}`, req.ID, req.ID))
+			default:
+				results = append(results, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"error":{"code":-32602,"message":"Invalid param"}}`, req.ID))
+			}
+		}
+		slices.Reverse(results)
+		w.Write([]byte("["))
+		for i, result := range results {
+			if i > 0 {
+				w.Write([]byte(","))
+			}
+			w.Write([]byte(result))
+		}
+		w.Write([]byte("]"))
+	}))
+}
+
+func TestBatch(t *testing.T) {
+	var posts int
+	server := newBatchServer(t, &posts)
+	defer server.Close()
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL))
+	batch := client.NewBatch()
+	pubkey := solana.MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY")
+	first := batch.GetBalance(pubkey)
+	failed := batch.GetTokenAccountBalance(pubkey)
+	second := batch.GetBalance(pubkey)
+
+	if _, err := first.Result(); err == nil {
+		t.Fatal("Expected error before the batch is sent")
+	}
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if posts != 1 {
+		t.Fatal("Expected a single request, got", posts)
+	}
+
+	if balance, err := first.Result(); err != nil || balance != 1 {
+		t.Fatal("Unexpected result for first call", balance, err)
+	}
+	if balance, err := second.Result(); err != nil || balance != 3 {
+		t.Fatal("Unexpected result for second call", balance, err)
+	}
+	if _, err := failed.Result(); err == nil {
+		t.Fatal("Expected error for failed call")
+	}
+}
+
+func TestBatchSplit(t *testing.T) {
+	var posts int
+	server := newBatchServer(t, &posts)
+	defer server.Close()
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL))
+	batch := client.NewBatch()
+	batch.MaxSize = 2
+	pubkey := solana.MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY")
+	var results []*BatchResult[uint]
+	for i := 0; i < 5; i++ {
+		results = append(results, batch.GetBalance(pubkey))
+	}
+	if batch.Len() != 5 {
+		t.Fatal("Unexpected batch length", batch.Len())
+	}
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if posts != 3 {
+		t.Fatal("Expected 3 requests, got", posts)
+	}
+	for i, result := range results {
+		if balance, err := result.Result(); err != nil || balance != uint(i+1) {
+			t.Fatal("Unexpected result", i, balance, err)
+		}
+	}
+	if batch.Len() != 0 {
+		t.Fatal("Expected batch to be empty after sending")
+	}
+}
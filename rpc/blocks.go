@@ -1,9 +1,7 @@
 package rpc
 
 import (
-	"encoding/base64"
-	"errors"
-	"fmt"
+	"context"
 	"time"
 
 	"github.com/hwsimmons17/solana-web3.go"
@@ -20,82 +18,80 @@ type block struct {
 	Rewards           []solana.TransactionReward `json:"rewards"`           //Block-level rewards, present if rewards are requested; an array of JSON objects containing:
 }
 
-func (r *RpcClient) GetBlock(slotNumber uint, config ...solana.GetBlockConfig) (*solana.Block, error) {
+func (r *RpcClient) GetBlock(ctx context.Context, slotNumber uint, config ...solana.GetBlockConfig) (*solana.Block, error) {
 	var res *block
 
-	// Set the encoding to base64 no matter what
-	encoding := solana.EncodingBase64
-	params := []interface{}{slotNumber}
-	if len(config) > 0 {
-		config[0].Encoding = &encoding
-		params = append(params, config[0])
-	} else {
-		params = append(params, solana.GetAccountInfoConfig{Encoding: &encoding})
-	}
-	if err := r.send("getBlock", params, &res); err != nil {
+	params := []interface{}{slotNumber, blockConfig(config)}
+	if err := r.send(ctx, "getBlock", params, &res); err != nil {
 		return nil, err
 	}
 	if res == nil {
 		return nil, nil
 	}
 
+	return res.block()
+}
+
+// Newest transaction version this package decodes, sent as maxSupportedTransactionVersion unless set otherwise.
+const maxSupportedTransactionVersion = 0
+
+// Returns the block config to send: base64 encoding no matter what, and every transaction version that can be decoded unless set otherwise.
+func blockConfig(config []solana.GetBlockConfig) solana.GetBlockConfig {
+	var res solana.GetBlockConfig
+	if len(config) > 0 {
+		res = config[0]
+	}
+	encoding := solana.EncodingBase64
+	res.Encoding = &encoding
+	if res.MaxSupportedTransactionVersion == nil {
+		version := maxSupportedTransactionVersion
+		res.MaxSupportedTransactionVersion = &version
+	}
+	return res
+}
+
+func (b *block) block() (*solana.Block, error) {
 	var txs []solana.TransactionWithMeta
-	for _, encodedTransaction := range res.Transactions {
-		if len(encodedTransaction.Transaction) == 0 {
-			return nil, errors.New("transaction not found")
-		}
-		transactionData, err := base64.StdEncoding.DecodeString(encodedTransaction.Transaction[0])
+	for _, encodedTransaction := range b.Transactions {
+		tx, err := encodedTransaction.transaction()
 		if err != nil {
-			return nil, fmt.Errorf("failed to decode transaction data: %v", err)
+			return nil, err
 		}
-		rawTx, err := solana.ParseTransactionData(transactionData)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse transaction data: %v", err)
-		}
-		tx, err := rawTx.Transaction()
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse transaction: %v", err)
-		}
-
-		txs = append(txs, solana.TransactionWithMeta{
-			Meta:        encodedTransaction.Meta,
-			Version:     encodedTransaction.Version,
-			Transaction: tx,
-		})
+		txs = append(txs, tx)
 	}
 
 	return &solana.Block{
-		BlockHeight:       res.BlockHeight,
-		BlockTime:         res.BlockTime,
-		Blockhash:         res.Blockhash,
-		ParentSlot:        res.ParentSlot,
-		PreviousBlockhash: res.PreviousBlockhash,
+		BlockHeight:       b.BlockHeight,
+		BlockTime:         b.BlockTime,
+		Blockhash:         b.Blockhash,
+		ParentSlot:        b.ParentSlot,
+		PreviousBlockhash: b.PreviousBlockhash,
 		Transactions:      txs,
-		Signatures:        res.Signatures,
-		Rewards:           res.Rewards,
+		Signatures:        b.Signatures,
+		Rewards:           b.Rewards,
 	}, nil
 }
 
-func (r *RpcClient) GetBlockCommitment(slotNumber uint) (solana.BlockCommitment, error) {
+func (r *RpcClient) GetBlockCommitment(ctx context.Context, slotNumber uint) (solana.BlockCommitment, error) {
 	var res solana.BlockCommitment
 	params := []interface{}{slotNumber}
-	if err := r.send("getBlockCommitment", params, &res); err != nil {
+	if err := r.send(ctx, "getBlockCommitment", params, &res); err != nil {
 		return solana.BlockCommitment{}, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetBlockHeight(config ...solana.StandardRpcConfig) (uint, error) {
+func (r *RpcClient) GetBlockHeight(ctx context.Context, config ...solana.StandardRpcConfig) (uint, error) {
 	var res uint
-	if err := r.send("getBlockHeight", nil, &res); err != nil {
+	if err := r.send(ctx, "getBlockHeight", nil, &res); err != nil {
 		return 0, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetBlockProduction(config ...solana.GetBlockProductionConfig) (solana.BlockProduction, error) {
+func (r *RpcClient) GetBlockProduction(ctx context.Context, config ...solana.GetBlockProductionConfig) (solana.BlockProduction, error) {
 	var res struct {
 		Value solana.BlockProduction `json:"value"`
 	}
@@ -103,141 +99,141 @@ func (r *RpcClient) GetBlockProduction(config ...solana.GetBlockProductionConfig
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getBlockProduction", params, &res); err != nil {
+	if err := r.send(ctx, "getBlockProduction", params, &res); err != nil {
 		return solana.BlockProduction{}, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetBlockTime(slotNumber uint) (time.Time, error) {
+func (r *RpcClient) GetBlockTime(ctx context.Context, slotNumber uint) (time.Time, error) {
 	var res int
 	params := []interface{}{slotNumber}
-	if err := r.send("getBlockTime", params, &res); err != nil {
+	if err := r.send(ctx, "getBlockTime", params, &res); err != nil {
 		return time.Time{}, err
 	}
 
 	return time.Unix(int64(res), 0), nil
 }
 
-func (r *RpcClient) GetBlocks(startSlot uint, endSlot *uint, config ...solana.GetBlockConfig) ([]uint, error) {
+func (r *RpcClient) GetBlocks(ctx context.Context, startSlot uint, endSlot *uint, config ...solana.GetBlockConfig) ([]uint, error) {
 	var res []uint
 	params := []interface{}{startSlot, endSlot}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getBlocks", params, &res); err != nil {
+	if err := r.send(ctx, "getBlocks", params, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetBlocksWithLimit(startSlot uint, limit uint, config ...solana.GetBlockConfig) ([]uint, error) {
+func (r *RpcClient) GetBlocksWithLimit(ctx context.Context, startSlot uint, limit uint, config ...solana.GetBlockConfig) ([]uint, error) {
 	var res []uint
 	params := []interface{}{startSlot, limit}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getBlocksWithLimit", params, &res); err != nil {
+	if err := r.send(ctx, "getBlocksWithLimit", params, &res); err != nil {
 		return nil, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetClusterNodes() ([]solana.ClusterNode, error) {
+func (r *RpcClient) GetClusterNodes(ctx context.Context) ([]solana.ClusterNode, error) {
 	var res []solana.ClusterNode
-	if err := r.send("getClusterNodes", nil, &res); err != nil {
+	if err := r.send(ctx, "getClusterNodes", nil, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetEpochInfo(config ...solana.StandardRpcConfig) (solana.EpochInfo, error) {
+func (r *RpcClient) GetEpochInfo(ctx context.Context, config ...solana.StandardRpcConfig) (solana.EpochInfo, error) {
 	var res solana.EpochInfo
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getEpochInfo", params, &res); err != nil {
+	if err := r.send(ctx, "getEpochInfo", params, &res); err != nil {
 		return solana.EpochInfo{}, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetEpochSchedule() (solana.EpochSchedule, error) {
+func (r *RpcClient) GetEpochSchedule(ctx context.Context) (solana.EpochSchedule, error) {
 	var res solana.EpochSchedule
-	if err := r.send("getEpochSchedule", nil, &res); err != nil {
+	if err := r.send(ctx, "getEpochSchedule", nil, &res); err != nil {
 		return solana.EpochSchedule{}, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetFirstAvailableBlock() (uint, error) {
+func (r *RpcClient) GetFirstAvailableBlock(ctx context.Context) (uint, error) {
 	var res uint
-	if err := r.send("getFirstAvailableBlock", nil, &res); err != nil {
+	if err := r.send(ctx, "getFirstAvailableBlock", nil, &res); err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetGenesisHash() (string, error) {
+func (r *RpcClient) GetGenesisHash(ctx context.Context) (string, error) {
 	var res string
-	if err := r.send("getGenesisHash", nil, &res); err != nil {
+	if err := r.send(ctx, "getGenesisHash", nil, &res); err != nil {
 		return "", err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetHighestSnapshotSlot() (solana.HighestSnapshotSlot, error) {
+func (r *RpcClient) GetHighestSnapshotSlot(ctx context.Context) (solana.HighestSnapshotSlot, error) {
 	var res solana.HighestSnapshotSlot
-	if err := r.send("getHighestSnapshotSlot", nil, &res); err != nil {
+	if err := r.send(ctx, "getHighestSnapshotSlot", nil, &res); err != nil {
 		return solana.HighestSnapshotSlot{}, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetLeaderSchedule(slot *uint, config ...solana.GetLeaderScheduleConfig) (*solana.LeaderSchedule, error) {
+func (r *RpcClient) GetLeaderSchedule(ctx context.Context, slot *uint, config ...solana.GetLeaderScheduleConfig) (*solana.LeaderSchedule, error) {
 	var res *solana.LeaderSchedule
 	params := []interface{}{slot}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getLeaderSchedule", params, &res); err != nil {
+	if err := r.send(ctx, "getLeaderSchedule", params, &res); err != nil {
 		return nil, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) GetMaxRetransmitSlot() (uint, error) {
+func (r *RpcClient) GetMaxRetransmitSlot(ctx context.Context) (uint, error) {
 	var res uint
-	if err := r.send("getMaxRetransmitSlot", nil, &res); err != nil {
+	if err := r.send(ctx, "getMaxRetransmitSlot", nil, &res); err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetMaxShredInsertSlot() (uint, error) {
+func (r *RpcClient) GetMaxShredInsertSlot(ctx context.Context) (uint, error) {
 	var res uint
-	if err := r.send("getMaxShredInsertSlot", nil, &res); err != nil {
+	if err := r.send(ctx, "getMaxShredInsertSlot", nil, &res); err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetRecentPerformanceSamples(limit uint) ([]solana.PerformanceSample, error) {
+func (r *RpcClient) GetRecentPerformanceSamples(ctx context.Context, limit uint) ([]solana.PerformanceSample, error) {
 	var res []solana.PerformanceSample
 	params := []interface{}{limit}
-	if err := r.send("getRecentPerformanceSamples", params, &res); err != nil {
+	if err := r.send(ctx, "getRecentPerformanceSamples", params, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetRecentPrioritizationFees(addresses []solana.Pubkey) ([]solana.PrioritizationFee, error) {
+func (r *RpcClient) GetRecentPrioritizationFees(ctx context.Context, addresses []solana.Pubkey) ([]solana.PrioritizationFee, error) {
 	var res []solana.PrioritizationFee
 	params := []interface{}{}
 	if len(addresses) > 0 {
@@ -247,40 +243,40 @@ func (r *RpcClient) GetRecentPrioritizationFees(addresses []solana.Pubkey) ([]so
 		}
 		params = append(params, strs)
 	}
-	if err := r.send("getRecentPrioritizationFees", params, &res); err != nil {
+	if err := r.send(ctx, "getRecentPrioritizationFees", params, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetSlot(config ...solana.StandardRpcConfig) (uint, error) {
+func (r *RpcClient) GetSlot(ctx context.Context, config ...solana.StandardRpcConfig) (uint, error) {
 	var res uint
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getSlot", params, &res); err != nil {
+	if err := r.send(ctx, "getSlot", params, &res); err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetSlotLeader(config ...solana.StandardRpcConfig) (solana.Pubkey, error) {
+func (r *RpcClient) GetSlotLeader(ctx context.Context, config ...solana.StandardRpcConfig) (solana.Pubkey, error) {
 	var res solana.PubkeyStr
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getSlotLeader", params, &res); err != nil {
+	if err := r.send(ctx, "getSlotLeader", params, &res); err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (r *RpcClient) GetSlotLeaders(start, limit *uint) ([]solana.Pubkey, error) {
+func (r *RpcClient) GetSlotLeaders(ctx context.Context, start, limit *uint) ([]solana.Pubkey, error) {
 	var res []solana.PubkeyStr
 	params := []interface{}{start, limit}
-	if err := r.send("getSlotLeaders", params, &res); err != nil {
+	if err := r.send(ctx, "getSlotLeaders", params, &res); err != nil {
 		return nil, err
 	}
 	var pubkeys []solana.Pubkey
@@ -290,33 +286,33 @@ func (r *RpcClient) GetSlotLeaders(start, limit *uint) ([]solana.Pubkey, error)
 	return pubkeys, nil
 }
 
-func (r *RpcClient) GetTransactionCount(config ...solana.StandardRpcConfig) (uint, error) {
+func (r *RpcClient) GetTransactionCount(ctx context.Context, config ...solana.StandardRpcConfig) (uint, error) {
 	var res uint
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getTransactionCount", params, &res); err != nil {
+	if err := r.send(ctx, "getTransactionCount", params, &res); err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetVoteAccounts(config ...solana.GetVoteAccountsConfig) (solana.VoteAccounts, error) {
+func (r *RpcClient) GetVoteAccounts(ctx context.Context, config ...solana.GetVoteAccountsConfig) (solana.VoteAccounts, error) {
 	var res solana.VoteAccounts
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getVoteAccounts", params, &res); err != nil {
+	if err := r.send(ctx, "getVoteAccounts", params, &res); err != nil {
 		return solana.VoteAccounts{}, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) MinimumLedgerSlot() (uint, error) {
+func (r *RpcClient) MinimumLedgerSlot(ctx context.Context) (uint, error) {
 	var res uint
-	if err := r.send("minimumLedgerSlot", nil, &res); err != nil {
+	if err := r.send(ctx, "minimumLedgerSlot", nil, &res); err != nil {
 		return 0, err
 	}
 	return res, nil
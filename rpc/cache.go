@@ -0,0 +1,270 @@
+package rpc
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// TTL of responses that never change, such as the genesis hash or a finalized block. They are kept until evicted.
+const CacheForever time.Duration = -1
+
+const defaultCacheSize = 1024
+
+// Default TTL of every method cached by Cache.
+var defaultCacheTTLs = map[string]time.Duration{
+	"getGenesisHash":                    CacheForever,
+	"getEpochSchedule":                  CacheForever,
+	"getBlock":                          CacheForever,
+	"getTransaction":                    CacheForever,
+	"getMinimumBalanceForRentExemption": time.Hour,
+	"getLeaderSchedule":                 10 * time.Minute,
+	"getVersion":                        10 * time.Minute,
+}
+
+// Methods whose responses are immutable once finalized, and which are only cached at finalized commitment.
+var finalizedOnlyMethods = map[string]bool{
+	"getBlock":       true,
+	"getTransaction": true,
+}
+
+var _ solana.RpcContext = (*Cache)(nil)
+
+// Caches the responses of a solana.RpcContext to calls returning slow-changing data: getGenesisHash, getEpochSchedule, getMinimumBalanceForRentExemption, getVersion, getLeaderSchedule of an explicit slot, and finalized getBlock and getTransaction. Every other call is passed through.
+// Calls at processed commitment are never cached, and blocks and transactions are only cached once finalized. Errors are not cached.
+// Cached values are shared between callers and must not be modified. Cache is safe for concurrent use.
+type Cache struct {
+	solana.RpcContext
+
+	store  CacheStore
+	ttls   map[string]time.Duration
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// Stores the responses cached by Cache. Keys are the JSON-RPC method name followed by the JSON encoded parameters, so a store can be shared by several caches of the same cluster.
+// Implementations must be safe for concurrent use.
+type CacheStore interface {
+	Get(key string) (value any, ok bool)          //Returns the value stored under key, unless it expired
+	Set(key string, value any, ttl time.Duration) //Stores value under key for ttl, or until evicted if ttl is CacheForever
+}
+
+// Hit and miss counts of a Cache.
+type CacheStats struct {
+	Hits   uint64 //Calls answered from the cache
+	Misses uint64 //Cacheable calls passed through to the wrapped client
+}
+
+// Configures a Cache.
+type CacheOption func(*Cache)
+
+// Sets the TTL of a cached JSON-RPC method, e.g. "getVersion". A TTL of zero disables caching of the method. Methods not cached by Cache are ignored.
+func WithCacheTTL(method string, ttl time.Duration) CacheOption {
+	return func(c *Cache) {
+		if _, ok := c.ttls[method]; ok {
+			c.ttls[method] = ttl
+		}
+	}
+}
+
+// Stores responses in store instead of an LRUStore holding 1024 responses.
+func WithCacheStore(store CacheStore) CacheOption {
+	return func(c *Cache) {
+		c.store = store
+	}
+}
+
+// Returns rpc wrapped with a cache. Use solana.ContextRpc and solana.BackgroundRpc to wrap a plain solana.Rpc.
+func NewCache(rpc solana.RpcContext, opts ...CacheOption) *Cache {
+	cache := &Cache{
+		RpcContext: rpc,
+		ttls:       map[string]time.Duration{},
+	}
+	for method, ttl := range defaultCacheTTLs {
+		cache.ttls[method] = ttl
+	}
+	for _, opt := range opts {
+		opt(cache)
+	}
+	if cache.store == nil {
+		cache.store = NewLRUStore(defaultCacheSize)
+	}
+	return cache
+}
+
+// Returns the hit and miss counts since the cache was created.
+func (c *Cache) Stats() CacheStats {
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+func (c *Cache) GetGenesisHash(ctx context.Context) (string, error) {
+	return cached(c, "getGenesisHash", nil, nil, func() (string, error) {
+		return c.RpcContext.GetGenesisHash(ctx)
+	})
+}
+
+func (c *Cache) GetEpochSchedule(ctx context.Context) (solana.EpochSchedule, error) {
+	return cached(c, "getEpochSchedule", nil, nil, func() (solana.EpochSchedule, error) {
+		return c.RpcContext.GetEpochSchedule(ctx)
+	})
+}
+
+func (c *Cache) GetVersion(ctx context.Context) (solana.Version, error) {
+	return cached(c, "getVersion", nil, nil, func() (solana.Version, error) {
+		return c.RpcContext.GetVersion(ctx)
+	})
+}
+
+func (c *Cache) GetMinimumBalanceForRentExemption(ctx context.Context, accountDataLength uint, config ...solana.StandardCommitmentConfig) (uint, error) {
+	var commitment *solana.Commitment
+	if len(config) > 0 {
+		commitment = config[0].Commitment
+	}
+	return cached(c, "getMinimumBalanceForRentExemption", commitment, []any{accountDataLength, config}, func() (uint, error) {
+		return c.RpcContext.GetMinimumBalanceForRentExemption(ctx, accountDataLength, config...)
+	})
+}
+
+func (c *Cache) GetLeaderSchedule(ctx context.Context, slot *uint, config ...solana.GetLeaderScheduleConfig) (*solana.LeaderSchedule, error) {
+	//Without a slot the schedule is the one of the current epoch, which changes at the next epoch boundary
+	if slot == nil {
+		return c.RpcContext.GetLeaderSchedule(ctx, slot, config...)
+	}
+	var commitment *solana.Commitment
+	if len(config) > 0 {
+		commitment = config[0].Commitment
+	}
+	return cached(c, "getLeaderSchedule", commitment, []any{slot, config}, func() (*solana.LeaderSchedule, error) {
+		return c.RpcContext.GetLeaderSchedule(ctx, slot, config...)
+	})
+}
+
+func (c *Cache) GetBlock(ctx context.Context, slotNumber uint, config ...solana.GetBlockConfig) (*solana.Block, error) {
+	var commitment *solana.Commitment
+	if len(config) > 0 {
+		commitment = config[0].Commitment
+	}
+	return cached(c, "getBlock", commitment, []any{slotNumber, config}, func() (*solana.Block, error) {
+		return c.RpcContext.GetBlock(ctx, slotNumber, config...)
+	})
+}
+
+func (c *Cache) GetTransaction(ctx context.Context, transactionSignature string, config ...solana.GetTransactionSignatureConfig) (*solana.TransactionWithMeta, error) {
+	var commitment *solana.Commitment
+	if len(config) > 0 {
+		commitment = config[0].Commitment
+	}
+	return cached(c, "getTransaction", commitment, []any{transactionSignature, config}, func() (*solana.TransactionWithMeta, error) {
+		return c.RpcContext.GetTransaction(ctx, transactionSignature, config...)
+	})
+}
+
+// Returns the cached response to method with params, calling fetch on a miss. commitment is the commitment of the call, nil if the node's default of finalized applies.
+func cached[T any](c *Cache, method string, commitment *solana.Commitment, params []any, fetch func() (T, error)) (T, error) {
+	ttl := c.ttls[method]
+	if ttl == 0 || !cacheable(method, commitment) {
+		return fetch()
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return fetch()
+	}
+	key := method + string(encoded)
+
+	if value, ok := c.store.Get(key); ok {
+		if res, ok := value.(T); ok {
+			c.hits.Add(1)
+			return res, nil
+		}
+	}
+	c.misses.Add(1)
+	res, err := fetch()
+	if err != nil {
+		return res, err
+	}
+	//A missing block or transaction may still show up, so only found ones are cached
+	if value := reflect.ValueOf(res); value.Kind() != reflect.Pointer || !value.IsNil() {
+		c.store.Set(key, res, ttl)
+	}
+	return res, nil
+}
+
+// Returns whether responses at commitment may be cached for method. Processed data may still be rolled back and is never cached.
+func cacheable(method string, commitment *solana.Commitment) bool {
+	if commitment == nil {
+		return true
+	}
+	if finalizedOnlyMethods[method] {
+		return *commitment == solana.CommitmentFinalized
+	}
+	return *commitment != solana.CommitmentProcessed
+}
+
+// A CacheStore keeping the most recently used entries in memory. LRUStore is safe for concurrent use.
+type LRUStore struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List //Most recently used entry first
+}
+
+type lruEntry struct {
+	key     string
+	value   any
+	expires time.Time //Zero if the entry never expires
+}
+
+// Returns a store holding up to capacity entries. The least recently used entry is evicted when it is full.
+func NewLRUStore(capacity int) *LRUStore {
+	return &LRUStore{capacity: max(capacity, 1), entries: map[string]*list.Element{}, order: list.New()}
+}
+
+func (s *LRUStore) Get(key string) (any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		s.order.Remove(element)
+		delete(s.entries, key)
+		return nil, false
+	}
+	s.order.MoveToFront(element)
+	return entry.value, true
+}
+
+func (s *LRUStore) Set(key string, value any, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var expires time.Time
+	if ttl != CacheForever {
+		expires = time.Now().Add(ttl)
+	}
+	if element, ok := s.entries[key]; ok {
+		element.Value = &lruEntry{key: key, value: value, expires: expires}
+		s.order.MoveToFront(element)
+		return
+	}
+	s.entries[key] = s.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Returns the number of entries in the store, including expired ones not yet evicted.
+func (s *LRUStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
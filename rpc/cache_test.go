@@ -0,0 +1,186 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Counts the requests made for every method and answers them with fixed results.
+type cacheTestServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newCacheTestServer() *cacheTestServer {
+	results := map[string]string{
+		"getGenesisHash":                    `"EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG"`,
+		"getVersion":                        `{"solana-core":"1.18.0","feature-set":1}`,
+		"getMinimumBalanceForRentExemption": `890880`,
+		"getSlot":                           `5`,
+		"getTransaction":                    `null`,
+		"getLeaderSchedule":                 `{"4Qkev8aNZcqFNSRhQzwyLMFSsi94jHqE8WNVTJzTBFQ1":[0,1,2,3]}`,
+	}
+	s := &cacheTestServer{calls: map[string]int{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcReq
+		json.NewDecoder(r.Body).Decode(&req)
+		s.mu.Lock()
+		s.calls[req.Method]++
+		s.mu.Unlock()
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":%s}`, req.ID, results[req.Method])
+	}))
+	return s
+}
+
+func (s *cacheTestServer) count(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func TestCache(t *testing.T) {
+	server := newCacheTestServer()
+	defer server.Close()
+	cache := NewCache(NewContextRpcClient(solana.RpcEndpoint(server.URL)))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if hash, err := cache.GetGenesisHash(ctx); err != nil || hash != "EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG" {
+			t.Fatal("Unexpected genesis hash", hash, err)
+		}
+		if _, err := cache.GetSlot(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if server.count("getGenesisHash") != 1 || server.count("getSlot") != 3 {
+		t.Fatal("Expected only the genesis hash to be cached", server.calls)
+	}
+
+	//Parameters are part of the key
+	for _, length := range []uint{0, 165, 165} {
+		if _, err := cache.GetMinimumBalanceForRentExemption(ctx, length); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if server.count("getMinimumBalanceForRentExemption") != 2 {
+		t.Fatal("Expected one request per account size", server.calls)
+	}
+
+	if stats := cache.Stats(); stats.Hits != 3 || stats.Misses != 3 {
+		t.Fatal("Unexpected stats", stats)
+	}
+}
+
+func TestCacheCommitment(t *testing.T) {
+	server := newCacheTestServer()
+	defer server.Close()
+	cache := NewCache(NewContextRpcClient(solana.RpcEndpoint(server.URL)))
+	ctx := context.Background()
+
+	processed := solana.CommitmentProcessed
+	confirmed := solana.CommitmentConfirmed
+	for i := 0; i < 2; i++ {
+		cache.GetMinimumBalanceForRentExemption(ctx, 0, solana.StandardCommitmentConfig{Commitment: &processed})
+		cache.GetMinimumBalanceForRentExemption(ctx, 1, solana.StandardCommitmentConfig{Commitment: &confirmed})
+	}
+	if server.count("getMinimumBalanceForRentExemption") != 3 {
+		t.Fatal("Expected processed calls not to be cached", server.calls)
+	}
+
+	if !cacheable("getBlock", nil) || cacheable("getBlock", &confirmed) || cacheable("getTransaction", &processed) {
+		t.Fatal("Expected blocks and transactions to be cached only when finalized")
+	}
+
+	//Transactions not found yet are not cached
+	for i := 0; i < 2; i++ {
+		if tx, err := cache.GetTransaction(ctx, "sig"); err != nil || tx != nil {
+			t.Fatal("Unexpected transaction", tx, err)
+		}
+	}
+	if server.count("getTransaction") != 2 {
+		t.Fatal("Expected missing transactions not to be cached", server.calls)
+	}
+}
+
+func TestCacheLeaderSchedule(t *testing.T) {
+	server := newCacheTestServer()
+	defer server.Close()
+	cache := NewCache(NewContextRpcClient(solana.RpcEndpoint(server.URL)))
+	ctx := context.Background()
+
+	//The schedule of the current epoch changes at the epoch boundary, so it is always fetched
+	for i := 0; i < 2; i++ {
+		if schedule, err := cache.GetLeaderSchedule(ctx, nil); err != nil || len(*schedule) != 1 {
+			t.Fatal("Unexpected leader schedule", schedule, err)
+		}
+	}
+	if server.count("getLeaderSchedule") != 2 {
+		t.Fatal("Expected the schedule of the current epoch not to be cached", server.calls)
+	}
+
+	slot := uint(432000)
+	for i := 0; i < 2; i++ {
+		if _, err := cache.GetLeaderSchedule(ctx, &slot); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if server.count("getLeaderSchedule") != 3 {
+		t.Fatal("Expected the schedule of an explicit slot to be cached", server.calls)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	server := newCacheTestServer()
+	defer server.Close()
+	cache := NewCache(NewContextRpcClient(solana.RpcEndpoint(server.URL)), WithCacheTTL("getVersion", 10*time.Millisecond), WithCacheTTL("getGenesisHash", 0))
+	ctx := context.Background()
+
+	cache.GetVersion(ctx)
+	cache.GetVersion(ctx)
+	time.Sleep(20 * time.Millisecond)
+	if version, err := cache.GetVersion(ctx); err != nil || version.SolanaCore != "1.18.0" {
+		t.Fatal("Unexpected version", version, err)
+	}
+	if server.count("getVersion") != 2 {
+		t.Fatal("Expected the version to expire", server.calls)
+	}
+
+	cache.GetGenesisHash(ctx)
+	cache.GetGenesisHash(ctx)
+	if server.count("getGenesisHash") != 2 {
+		t.Fatal("Expected caching to be disabled", server.calls)
+	}
+}
+
+func TestLRUStore(t *testing.T) {
+	store := NewLRUStore(2)
+	store.Set("a", 1, CacheForever)
+	store.Set("b", 2, CacheForever)
+	store.Get("a")
+	store.Set("c", 3, CacheForever)
+	if _, ok := store.Get("b"); ok {
+		t.Fatal("Expected the least recently used entry to be evicted")
+	}
+	if value, ok := store.Get("a"); !ok || value != 1 {
+		t.Fatal("Unexpected value", value, ok)
+	}
+	if store.Len() != 2 {
+		t.Fatal("Unexpected length", store.Len())
+	}
+
+	store.Set("d", 4, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := store.Get("d"); ok {
+		t.Fatal("Expected the entry to expire")
+	}
+}
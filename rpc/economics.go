@@ -1,32 +1,36 @@
 package rpc
 
-import "github.com/hwsimmons17/solana-web3.go"
+import (
+	"context"
 
-func (r *RpcClient) GetInflationGovernor(config ...solana.StandardCommitmentConfig) (solana.InflationGovernor, error) {
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+func (r *RpcClient) GetInflationGovernor(ctx context.Context, config ...solana.StandardCommitmentConfig) (solana.InflationGovernor, error) {
 	var res solana.InflationGovernor
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getInflationGovernor", params, &res); err != nil {
+	if err := r.send(ctx, "getInflationGovernor", params, &res); err != nil {
 		return solana.InflationGovernor{}, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetInflationRate(config ...solana.StandardCommitmentConfig) (solana.InflationRate, error) {
+func (r *RpcClient) GetInflationRate(ctx context.Context, config ...solana.StandardCommitmentConfig) (solana.InflationRate, error) {
 	var res solana.InflationRate
 	params := []interface{}{}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getInflationRate", params, &res); err != nil {
+	if err := r.send(ctx, "getInflationRate", params, &res); err != nil {
 		return solana.InflationRate{}, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetInflationReward(addresses []solana.Pubkey, config ...solana.GetInflationRewardConfig) ([]*solana.InflationReward, error) {
+func (r *RpcClient) GetInflationReward(ctx context.Context, addresses []solana.Pubkey, config ...solana.GetInflationRewardConfig) ([]*solana.InflationReward, error) {
 	var res []*solana.InflationReward
 	params := []interface{}{}
 	var strs []string
@@ -37,13 +41,13 @@ func (r *RpcClient) GetInflationReward(addresses []solana.Pubkey, config ...sola
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getInflationReward", params, &res); err != nil {
+	if err := r.send(ctx, "getInflationReward", params, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetStakeMinimumDelegation(config ...solana.StandardCommitmentConfig) (uint, error) {
+func (r *RpcClient) GetStakeMinimumDelegation(ctx context.Context, config ...solana.StandardCommitmentConfig) (uint, error) {
 	var res struct {
 		Value uint `json:"value"`
 	}
@@ -51,13 +55,13 @@ func (r *RpcClient) GetStakeMinimumDelegation(config ...solana.StandardCommitmen
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getStakeMinimumDelegation", params, &res); err != nil {
+	if err := r.send(ctx, "getStakeMinimumDelegation", params, &res); err != nil {
 		return 0, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetSupply(config ...solana.GetSupplyConfig) (solana.Supply, error) {
+func (r *RpcClient) GetSupply(ctx context.Context, config ...solana.GetSupplyConfig) (solana.Supply, error) {
 	var res struct {
 		Value solana.Supply `json:"value"`
 	}
@@ -65,7 +69,7 @@ func (r *RpcClient) GetSupply(config ...solana.GetSupplyConfig) (solana.Supply,
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getSupply", params, &res); err != nil {
+	if err := r.send(ctx, "getSupply", params, &res); err != nil {
 		return solana.Supply{}, err
 	}
 	return res.Value, nil
@@ -0,0 +1,442 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Decides how a call to an RPC method is made. method is the JSON-RPC method name, e.g. "getBalance". call makes the request on the given client and may be invoked several times, e.g. to fail over to another node, or not at all.
+type Handler func(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error
+
+// Returns a solana.RpcContext passing every call through handler. It is the building block of Pool and RateLimiter and can be used for custom middleware such as logging or metrics.
+func Intercept(handler Handler) solana.RpcContext {
+	return &interceptor{handler: handler}
+}
+
+type interceptor struct {
+	handler Handler
+}
+
+func (i *interceptor) GetAccountInfo(ctx context.Context, address solana.Pubkey, config ...solana.GetAccountInfoConfig) (res *solana.Account, err error) {
+	err = i.handler(ctx, "getAccountInfo", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetAccountInfo(ctx, address, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBalance(ctx context.Context, address solana.Pubkey, config ...solana.StandardRpcConfig) (res uint, err error) {
+	err = i.handler(ctx, "getBalance", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBalance(ctx, address, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlock(ctx context.Context, slotNumber uint, config ...solana.GetBlockConfig) (res *solana.Block, err error) {
+	err = i.handler(ctx, "getBlock", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlock(ctx, slotNumber, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlockCommitment(ctx context.Context, slotNumber uint) (res solana.BlockCommitment, err error) {
+	err = i.handler(ctx, "getBlockCommitment", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlockCommitment(ctx, slotNumber)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlockHeight(ctx context.Context, config ...solana.StandardRpcConfig) (res uint, err error) {
+	err = i.handler(ctx, "getBlockHeight", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlockHeight(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlockProduction(ctx context.Context, config ...solana.GetBlockProductionConfig) (res solana.BlockProduction, err error) {
+	err = i.handler(ctx, "getBlockProduction", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlockProduction(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlockTime(ctx context.Context, slotNumber uint) (res time.Time, err error) {
+	err = i.handler(ctx, "getBlockTime", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlockTime(ctx, slotNumber)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlocks(ctx context.Context, startSlot uint, endSlot *uint, config ...solana.GetBlockConfig) (res []uint, err error) {
+	err = i.handler(ctx, "getBlocks", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlocks(ctx, startSlot, endSlot, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetBlocksWithLimit(ctx context.Context, startSlot uint, limit uint, config ...solana.GetBlockConfig) (res []uint, err error) {
+	err = i.handler(ctx, "getBlocksWithLimit", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetBlocksWithLimit(ctx, startSlot, limit, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetClusterNodes(ctx context.Context) (res []solana.ClusterNode, err error) {
+	err = i.handler(ctx, "getClusterNodes", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetClusterNodes(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetEpochInfo(ctx context.Context, config ...solana.StandardRpcConfig) (res solana.EpochInfo, err error) {
+	err = i.handler(ctx, "getEpochInfo", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetEpochInfo(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetEpochSchedule(ctx context.Context) (res solana.EpochSchedule, err error) {
+	err = i.handler(ctx, "getEpochSchedule", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetEpochSchedule(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetFeeForMessage(ctx context.Context, msg []byte, config ...solana.StandardRpcConfig) (res *uint, err error) {
+	err = i.handler(ctx, "getFeeForMessage", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetFeeForMessage(ctx, msg, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetFirstAvailableBlock(ctx context.Context) (res uint, err error) {
+	err = i.handler(ctx, "getFirstAvailableBlock", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetFirstAvailableBlock(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetGenesisHash(ctx context.Context) (res string, err error) {
+	err = i.handler(ctx, "getGenesisHash", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetGenesisHash(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetHealth(ctx context.Context) error {
+	return i.handler(ctx, "getHealth", func(ctx context.Context, rpc solana.RpcContext) error {
+		return rpc.GetHealth(ctx)
+	})
+}
+
+func (i *interceptor) GetHighestSnapshotSlot(ctx context.Context) (res solana.HighestSnapshotSlot, err error) {
+	err = i.handler(ctx, "getHighestSnapshotSlot", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetHighestSnapshotSlot(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetIdentity(ctx context.Context) (res solana.Pubkey, err error) {
+	err = i.handler(ctx, "getIdentity", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetIdentity(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetInflationGovernor(ctx context.Context, config ...solana.StandardCommitmentConfig) (res solana.InflationGovernor, err error) {
+	err = i.handler(ctx, "getInflationGovernor", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetInflationGovernor(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetInflationRate(ctx context.Context, config ...solana.StandardCommitmentConfig) (res solana.InflationRate, err error) {
+	err = i.handler(ctx, "getInflationRate", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetInflationRate(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetInflationReward(ctx context.Context, addresses []solana.Pubkey, config ...solana.GetInflationRewardConfig) (res []*solana.InflationReward, err error) {
+	err = i.handler(ctx, "getInflationReward", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetInflationReward(ctx, addresses, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetLargestAccounts(ctx context.Context, config ...solana.GetLargestAccountsConfig) (res []solana.AccountWithBalance, err error) {
+	err = i.handler(ctx, "getLargestAccounts", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetLargestAccounts(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetLatestBlockhash(ctx context.Context, config ...solana.StandardRpcConfig) (res solana.LatestBlockhash, err error) {
+	err = i.handler(ctx, "getLatestBlockhash", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetLatestBlockhash(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetLeaderSchedule(ctx context.Context, slot *uint, config ...solana.GetLeaderScheduleConfig) (res *solana.LeaderSchedule, err error) {
+	err = i.handler(ctx, "getLeaderSchedule", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetLeaderSchedule(ctx, slot, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetMaxRetransmitSlot(ctx context.Context) (res uint, err error) {
+	err = i.handler(ctx, "getMaxRetransmitSlot", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetMaxRetransmitSlot(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetMaxShredInsertSlot(ctx context.Context) (res uint, err error) {
+	err = i.handler(ctx, "getMaxShredInsertSlot", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetMaxShredInsertSlot(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetMinimumBalanceForRentExemption(ctx context.Context, accountDataLength uint, config ...solana.StandardCommitmentConfig) (res uint, err error) {
+	err = i.handler(ctx, "getMinimumBalanceForRentExemption", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetMinimumBalanceForRentExemption(ctx, accountDataLength, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetMultipleAccounts(ctx context.Context, pubkeys []solana.Pubkey, config ...solana.GetAccountInfoConfig) (res []*solana.Account, err error) {
+	err = i.handler(ctx, "getMultipleAccounts", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetMultipleAccounts(ctx, pubkeys, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetProgramAccounts(ctx context.Context, programPubkey solana.Pubkey, config ...solana.GetAccountInfoConfig) (res []solana.Account, err error) {
+	err = i.handler(ctx, "getProgramAccounts", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetProgramAccounts(ctx, programPubkey, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetRecentPerformanceSamples(ctx context.Context, limit uint) (res []solana.PerformanceSample, err error) {
+	err = i.handler(ctx, "getRecentPerformanceSamples", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetRecentPerformanceSamples(ctx, limit)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetRecentPrioritizationFees(ctx context.Context, addresses []solana.Pubkey) (res []solana.PrioritizationFee, err error) {
+	err = i.handler(ctx, "getRecentPrioritizationFees", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetRecentPrioritizationFees(ctx, addresses)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetSignatureStatuses(ctx context.Context, signatures []string, config ...solana.GetSignatureStatusesConfig) (res []*solana.SignatureStatus, err error) {
+	err = i.handler(ctx, "getSignatureStatuses", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetSignatureStatuses(ctx, signatures, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetSignaturesForAddress(ctx context.Context, address solana.Pubkey, config ...solana.GetSignaturesForAddressConfig) (res []solana.TransactionSignature, err error) {
+	err = i.handler(ctx, "getSignaturesForAddress", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetSignaturesForAddress(ctx, address, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetSlot(ctx context.Context, config ...solana.StandardRpcConfig) (res uint, err error) {
+	err = i.handler(ctx, "getSlot", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetSlot(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetSlotLeader(ctx context.Context, config ...solana.StandardRpcConfig) (res solana.Pubkey, err error) {
+	err = i.handler(ctx, "getSlotLeader", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetSlotLeader(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetSlotLeaders(ctx context.Context, start *uint, limit *uint) (res []solana.Pubkey, err error) {
+	err = i.handler(ctx, "getSlotLeaders", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetSlotLeaders(ctx, start, limit)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetStakeMinimumDelegation(ctx context.Context, config ...solana.StandardCommitmentConfig) (res uint, err error) {
+	err = i.handler(ctx, "getStakeMinimumDelegation", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetStakeMinimumDelegation(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetSupply(ctx context.Context, config ...solana.GetSupplyConfig) (res solana.Supply, err error) {
+	err = i.handler(ctx, "getSupply", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetSupply(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTokenAccountBalance(ctx context.Context, address solana.Pubkey, config ...solana.StandardCommitmentConfig) (res solana.UiTokenAmount, err error) {
+	err = i.handler(ctx, "getTokenAccountBalance", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTokenAccountBalance(ctx, address, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTokenAccountsByDelegate(ctx context.Context, delegateAddress solana.Pubkey, opts solana.GetTokenAccountsByDelegateConfig, config ...solana.GetAccountInfoConfig) (res []solana.Account, err error) {
+	err = i.handler(ctx, "getTokenAccountsByDelegate", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTokenAccountsByDelegate(ctx, delegateAddress, opts, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTokenAccountsByOwner(ctx context.Context, ownerAddress solana.Pubkey, opts solana.GetTokenAccountsByDelegateConfig, config ...solana.GetAccountInfoConfig) (res []solana.Account, err error) {
+	err = i.handler(ctx, "getTokenAccountsByOwner", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTokenAccountsByOwner(ctx, ownerAddress, opts, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTokenLargestAccounts(ctx context.Context, mintAddress solana.Pubkey, config ...solana.StandardCommitmentConfig) (res []solana.UiTokenAmount, err error) {
+	err = i.handler(ctx, "getTokenLargestAccounts", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTokenLargestAccounts(ctx, mintAddress, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTokenSupply(ctx context.Context, mintAddress solana.Pubkey, config ...solana.StandardCommitmentConfig) (res solana.UiTokenAmount, err error) {
+	err = i.handler(ctx, "getTokenSupply", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTokenSupply(ctx, mintAddress, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTransaction(ctx context.Context, transactionSignature string, config ...solana.GetTransactionSignatureConfig) (res *solana.TransactionWithMeta, err error) {
+	err = i.handler(ctx, "getTransaction", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTransaction(ctx, transactionSignature, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetTransactionCount(ctx context.Context, config ...solana.StandardRpcConfig) (res uint, err error) {
+	err = i.handler(ctx, "getTransactionCount", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetTransactionCount(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetVersion(ctx context.Context) (res solana.Version, err error) {
+	err = i.handler(ctx, "getVersion", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetVersion(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetVoteAccounts(ctx context.Context, config ...solana.GetVoteAccountsConfig) (res solana.VoteAccounts, err error) {
+	err = i.handler(ctx, "getVoteAccounts", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetVoteAccounts(ctx, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) IsBlockhashValid(ctx context.Context, blockhash string, config ...solana.StandardRpcConfig) (res bool, err error) {
+	err = i.handler(ctx, "isBlockhashValid", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.IsBlockhashValid(ctx, blockhash, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) MinimumLedgerSlot(ctx context.Context) (res uint, err error) {
+	err = i.handler(ctx, "minimumLedgerSlot", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.MinimumLedgerSlot(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) RequestAirdrop(ctx context.Context, destinationAddress solana.Pubkey, lamports uint, config ...solana.StandardCommitmentConfig) (res string, err error) {
+	err = i.handler(ctx, "requestAirdrop", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.RequestAirdrop(ctx, destinationAddress, lamports, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) SendTransaction(ctx context.Context, fullySignedTransaction string, config ...solana.SendTransactionConfig) (res string, err error) {
+	err = i.handler(ctx, "sendTransaction", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.SendTransaction(ctx, fullySignedTransaction, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) SimulateTransaction(ctx context.Context, transaction string, config ...solana.SimulateTransactionConfig) (res solana.SimulateTransactionResult, err error) {
+	err = i.handler(ctx, "simulateTransaction", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.SimulateTransaction(ctx, transaction, config...)
+		return err
+	})
+	return res, err
+}
+
+func (i *interceptor) GetAsset(ctx context.Context, pubkey solana.Pubkey, config ...solana.GetAssetConfig) (res solana.GetAssetResult, err error) {
+	err = i.handler(ctx, "getAsset", func(ctx context.Context, rpc solana.RpcContext) (err error) {
+		res, err = rpc.GetAsset(ctx, pubkey, config...)
+		return err
+	})
+	return res, err
+}
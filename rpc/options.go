@@ -0,0 +1,157 @@
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Configures an RpcClient. Options are applied in order, so later options override earlier ones.
+type Option func(*RpcClient)
+
+// Sets the HTTP client used for every request. The client is shared between calls so connections are reused. A nil client means http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(r *RpcClient) {
+		if client == nil {
+			client = http.DefaultClient
+		}
+		r.httpClient = client
+	}
+}
+
+// Sets the RoundTripper of the client's HTTP client, e.g. an httptest transport or an instrumented one.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *RpcClient) {
+		client := *r.httpClient
+		client.Transport = transport
+		r.httpClient = &client
+	}
+}
+
+// Sets a time limit for each HTTP request made by the client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *RpcClient) {
+		client := *r.httpClient
+		client.Timeout = timeout
+		r.httpClient = &client
+	}
+}
+
+// Sends every request through the given proxy.
+func WithProxy(proxy *url.URL) Option {
+	return func(r *RpcClient) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyURL(proxy)
+		client := *r.httpClient
+		client.Transport = transport
+		r.httpClient = &client
+	}
+}
+
+// Adds a static header to every request, e.g. the API key header of a paid RPC provider.
+func WithHeader(key, value string) Option {
+	return func(r *RpcClient) {
+		r.headers.Add(key, value)
+	}
+}
+
+// Calls fn before every request so headers can be derived from the request's context, e.g. a request ID or a short-lived token.
+func WithHeaderFunc(fn func(ctx context.Context, header http.Header)) Option {
+	return func(r *RpcClient) {
+		r.headerFuncs = append(r.headerFuncs, fn)
+	}
+}
+
+// Authenticates every request with HTTP basic auth.
+func WithBasicAuth(username, password string) Option {
+	return func(r *RpcClient) {
+		r.headerFuncs = append(r.headerFuncs, func(_ context.Context, header http.Header) {
+			req := http.Request{Header: header}
+			req.SetBasicAuth(username, password)
+		})
+	}
+}
+
+// Authenticates every request with an "Authorization: Bearer" header.
+func WithBearerToken(token string) Option {
+	return func(r *RpcClient) {
+		r.headers.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// Sets the User-Agent header of every request, e.g. to identify the application to the RPC provider.
+func WithUserAgent(userAgent string) Option {
+	return func(r *RpcClient) {
+		r.headers.Set("User-Agent", userAgent)
+	}
+}
+
+// Compresses request bodies with gzip and asks the server for gzip compressed responses.
+func WithGzip() Option {
+	return func(r *RpcClient) {
+		r.gzip = true
+	}
+}
+
+func (r *RpcClient) newHTTPRequest(ctx context.Context, data []byte) (*http.Request, error) {
+	var body io.Reader = bytes.NewReader(data)
+	if r.gzip {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		body = &buf
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, string(r.Endpoint), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range r.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if r.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	for _, fn := range r.headerFuncs {
+		fn(ctx, req.Header)
+	}
+	return req, nil
+}
+
+// Setting Accept-Encoding ourselves disables the transport's transparent decompression, so responses are decompressed here.
+func decompressResponse(resp *http.Response) error {
+	if resp.Header.Get("Content-Encoding") != "gzip" {
+		return nil
+	}
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return err
+	}
+	resp.Body = &gzipBody{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	return nil
+}
+
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
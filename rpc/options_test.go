@@ -0,0 +1,174 @@
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHeaderOptions(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":1}`))
+	}))
+	defer server.Close()
+
+	type requestIDKey struct{}
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL),
+		WithHeader("X-Api-Key", "secret"),
+		WithUserAgent("solana-web3.go-test"),
+		WithBasicAuth("user", "pass"),
+		WithHeaderFunc(func(ctx context.Context, header http.Header) {
+			header.Set("X-Request-Id", ctx.Value(requestIDKey{}).(string))
+		}),
+	)
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "abc")
+	if _, err := client.GetSlot(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if header.Get("X-Api-Key") != "secret" {
+		t.Fatal("Unexpected api key header", header.Get("X-Api-Key"))
+	}
+	if header.Get("User-Agent") != "solana-web3.go-test" {
+		t.Fatal("Unexpected user agent", header.Get("User-Agent"))
+	}
+	if header.Get("X-Request-Id") != "abc" {
+		t.Fatal("Unexpected request id header", header.Get("X-Request-Id"))
+	}
+	username, password, ok := (&http.Request{Header: header}).BasicAuth()
+	if !ok || username != "user" || password != "pass" {
+		t.Fatal("Unexpected basic auth", username, password)
+	}
+	if header.Get("Content-Type") != "application/json" {
+		t.Fatal("Unexpected content type", header.Get("Content-Type"))
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":1}`))
+	}))
+	defer server.Close()
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithBearerToken("token"))
+	if _, err := client.GetSlot(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if authorization != "Bearer token" {
+		t.Fatal("Unexpected authorization header", authorization)
+	}
+}
+
+func TestGzip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "gzip" {
+			t.Error("Expected gzip request body")
+			return
+		}
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		var req rpcReq
+		if err := json.NewDecoder(reader).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		if req.Method != "getSlot" {
+			t.Error("Unexpected method", req.Method)
+		}
+
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Error("Expected gzip to be accepted")
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		writer.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":7}`))
+		writer.Close()
+	}))
+	defer server.Close()
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithGzip())
+	slot, err := client.GetSlot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 7 {
+		t.Fatal("Unexpected slot", slot)
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	var calls int
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"result":"ok"}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, err := NewRpcClientWithHealthCheck("http://rpc.invalid", WithTransport(transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.GetHealth(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatal("Expected 2 calls through the transport, got", calls)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithTimeout(50*time.Millisecond))
+	if _, err := client.GetSlot(context.Background()); err == nil {
+		t.Fatal("Expected timeout error")
+	}
+}
+
+func TestWithNilHTTPClient(t *testing.T) {
+	client := NewContextRpcClient("http://rpc.invalid", WithHTTPClient(nil), WithTimeout(time.Second), WithTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"result":7}`)),
+			Request:    req,
+		}, nil
+	})))
+	if slot, err := client.GetSlot(context.Background()); err != nil || slot != 7 {
+		t.Fatal("Unexpected slot", slot, err)
+	}
+	if http.DefaultClient.Timeout != 0 || http.DefaultClient.Transport != nil {
+		t.Fatal("Expected http.DefaultClient to be left untouched")
+	}
+}
@@ -0,0 +1,339 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Decides which healthy node of a Pool serves a call.
+type Strategy int
+
+const (
+	StrategyRoundRobin      Strategy = iota //Cycles through the healthy nodes
+	StrategyLatencyWeighted                 //Picks nodes at random, weighted by the inverse of their average latency
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultMaxSlotLag          = 50
+
+	//Weight of the latest sample in a node's average latency
+	latencySmoothing = 0.2
+)
+
+var _ solana.RpcContext = (*Pool)(nil)
+
+// Spreads calls over several RPC nodes. Nodes failing the periodic health check, or lagging too many slots behind the other nodes, are taken out of rotation until they recover.
+// Calls failing with a retryable error, such as a transport failure, fail over to the next node. Pool is safe for concurrent use.
+type Pool struct {
+	*interceptor
+
+	nodes               []*poolNode
+	strategy            Strategy
+	healthCheckInterval time.Duration
+	maxSlotLag          uint
+	fanOut              bool
+	clientOptions       []Option
+	rateLimits          []RateLimitOption
+
+	next   atomic.Uint64
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type poolNode struct {
+	endpoint solana.RpcEndpoint
+	rpc      solana.RpcContext
+
+	mu      sync.Mutex
+	healthy bool
+	slot    uint
+	latency time.Duration
+}
+
+// Health of a node as last seen by the pool.
+type NodeStatus struct {
+	Endpoint solana.RpcEndpoint //The node's endpoint
+	Healthy  bool               //Whether the node is in rotation
+	Slot     uint               //Slot reported by the last health check
+	Latency  time.Duration      //Average latency of the node's responses
+}
+
+// Configures a Pool.
+type PoolOption func(*Pool)
+
+// Sets how calls are spread over the healthy nodes. Defaults to StrategyRoundRobin.
+func WithStrategy(strategy Strategy) PoolOption {
+	return func(p *Pool) {
+		p.strategy = strategy
+	}
+}
+
+// Sets how often nodes are checked with getHealth and getSlot, and how many slots a node may lag behind the most advanced node before it is taken out of rotation. An interval of zero disables health checks.
+func WithHealthCheck(interval time.Duration, maxSlotLag uint) PoolOption {
+	return func(p *Pool) {
+		p.healthCheckInterval = interval
+		p.maxSlotLag = maxSlotLag
+	}
+}
+
+// Sends transactions to every healthy node at once, so they reach the leader through several paths. The first signature returned wins.
+func WithSendFanOut() PoolOption {
+	return func(p *Pool) {
+		p.fanOut = true
+	}
+}
+
+// Sets the options of the client created for every endpoint. Clients are created without retries, as the pool fails over to another node instead, unless a retry policy is given here.
+func WithClientOptions(opts ...Option) PoolOption {
+	return func(p *Pool) {
+		p.clientOptions = append(p.clientOptions, opts...)
+	}
+}
+
+// Gives every node its own RateLimiter with the given limits, so each endpoint's limits are respected independently.
+func WithNodeRateLimits(opts ...RateLimitOption) PoolOption {
+	return func(p *Pool) {
+		p.rateLimits = append(p.rateLimits, opts...)
+	}
+}
+
+// Returns a pool spreading calls over the given endpoints. Health checks run in the background until Close is called.
+func NewPool(endpoints []solana.RpcEndpoint, opts ...PoolOption) (*Pool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("pool needs at least one endpoint")
+	}
+	pool := &Pool{
+		healthCheckInterval: defaultHealthCheckInterval,
+		maxSlotLag:          defaultMaxSlotLag,
+		done:                make(chan struct{}),
+	}
+	pool.interceptor = &interceptor{handler: pool.handle}
+	for _, opt := range opts {
+		opt(pool)
+	}
+
+	clientOptions := append([]Option{WithRetryPolicy(RetryPolicy{})}, pool.clientOptions...)
+	for _, endpoint := range endpoints {
+		var rpc solana.RpcContext = NewContextRpcClient(endpoint, clientOptions...)
+		if len(pool.rateLimits) > 0 {
+			rpc = NewRateLimiter(rpc, pool.rateLimits...)
+		}
+		pool.nodes = append(pool.nodes, &poolNode{endpoint: endpoint, rpc: rpc, healthy: true})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.cancel = cancel
+	if pool.healthCheckInterval > 0 {
+		go pool.monitor(ctx)
+	} else {
+		close(pool.done)
+	}
+	return pool, nil
+}
+
+// Stops the background health checks.
+func (p *Pool) Close() {
+	p.cancel()
+	<-p.done
+}
+
+// Returns the status of every node, in the order the endpoints were given.
+func (p *Pool) Nodes() []NodeStatus {
+	statuses := make([]NodeStatus, len(p.nodes))
+	for i, node := range p.nodes {
+		node.mu.Lock()
+		statuses[i] = NodeStatus{Endpoint: node.endpoint, Healthy: node.healthy, Slot: node.slot, Latency: node.latency}
+		node.mu.Unlock()
+	}
+	return statuses
+}
+
+func (p *Pool) handle(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error {
+	var err error
+	for _, node := range p.order() {
+		start := time.Now()
+		err = call(ctx, node.rpc)
+		if err == nil {
+			node.observe(time.Since(start))
+			return nil
+		}
+		if !IsRetryable(err) || ctx.Err() != nil {
+			return err
+		}
+		//Take the node out of rotation until the next health check shows it recovered
+		node.setHealthy(false)
+	}
+	return err
+}
+
+// Returns the nodes in the order they should be tried: the node picked by the strategy first, then the remaining healthy nodes, then the unhealthy ones as a last resort.
+func (p *Pool) order() []*poolNode {
+	var healthy, unhealthy []*poolNode
+	for _, node := range p.nodes {
+		if node.isHealthy() {
+			healthy = append(healthy, node)
+		} else {
+			unhealthy = append(unhealthy, node)
+		}
+	}
+	if len(healthy) > 1 {
+		first := p.pick(healthy)
+		healthy[0], healthy[first] = healthy[first], healthy[0]
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (p *Pool) pick(nodes []*poolNode) int {
+	if p.strategy == StrategyLatencyWeighted {
+		weights := make([]float64, len(nodes))
+		var total float64
+		for i, node := range nodes {
+			node.mu.Lock()
+			latency := max(node.latency, time.Millisecond)
+			node.mu.Unlock()
+			weights[i] = 1 / latency.Seconds()
+			total += weights[i]
+		}
+		target := rand.Float64() * total
+		for i, weight := range weights {
+			if target < weight {
+				return i
+			}
+			target -= weight
+		}
+		return len(nodes) - 1
+	}
+	return int((p.next.Add(1) - 1) % uint64(len(nodes)))
+}
+
+// Sends the transaction to every healthy node when fan out is enabled, otherwise to a single node like any other call.
+func (p *Pool) SendTransaction(ctx context.Context, transaction string, config ...solana.SendTransactionConfig) (string, error) {
+	if !p.fanOut {
+		return p.interceptor.SendTransaction(ctx, transaction, config...)
+	}
+
+	nodes := p.order()
+	var healthy []*poolNode
+	for _, node := range nodes {
+		if node.isHealthy() {
+			healthy = append(healthy, node)
+		}
+	}
+	if len(healthy) == 0 {
+		healthy = nodes
+	}
+
+	type result struct {
+		signature string
+		err       error
+	}
+	results := make(chan result, len(healthy))
+	for _, node := range healthy {
+		go func() {
+			signature, err := node.rpc.SendTransaction(ctx, transaction, config...)
+			results <- result{signature, err}
+		}()
+	}
+	var firstErr error
+	for range healthy {
+		res := <-results
+		if res.err == nil {
+			return res.signature, nil
+		}
+		if firstErr == nil {
+			firstErr = res.err
+		}
+	}
+	return "", firstErr
+}
+
+func (p *Pool) monitor(ctx context.Context) {
+	defer close(p.done)
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		p.checkHealth(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Checks every node with getHealth and getSlot. Nodes that fail, or lag more than maxSlotLag slots behind the most advanced node, are taken out of rotation.
+func (p *Pool) checkHealth(parent context.Context) {
+	ctx, cancel := context.WithTimeout(parent, p.healthCheckInterval)
+	defer cancel()
+
+	type check struct {
+		slot uint
+		err  error
+	}
+	checks := make([]check, len(p.nodes))
+	var wg sync.WaitGroup
+	for i, node := range p.nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			if err := node.rpc.GetHealth(ctx); err != nil {
+				checks[i].err = err
+				return
+			}
+			checks[i].slot, checks[i].err = node.rpc.GetSlot(ctx)
+			if checks[i].err == nil {
+				node.observe(time.Since(start) / 2)
+			}
+		}()
+	}
+	wg.Wait()
+	if parent.Err() != nil {
+		return
+	}
+
+	var highest uint
+	for _, check := range checks {
+		if check.err == nil {
+			highest = max(highest, check.slot)
+		}
+	}
+	for i, node := range p.nodes {
+		healthy := checks[i].err == nil && checks[i].slot+p.maxSlotLag >= highest
+		node.mu.Lock()
+		node.healthy = healthy
+		if checks[i].err == nil {
+			node.slot = checks[i].slot
+		}
+		node.mu.Unlock()
+	}
+}
+
+func (n *poolNode) isHealthy() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.healthy
+}
+
+func (n *poolNode) setHealthy(healthy bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.healthy = healthy
+}
+
+func (n *poolNode) observe(latency time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.latency == 0 {
+		n.latency = latency
+		return
+	}
+	n.latency = time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(n.latency))
+}
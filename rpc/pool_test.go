@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Answers getHealth, getSlot with the given slot and sendTransaction with a fixed signature. Counts every other call and fails them when failing is set.
+type poolTestServer struct {
+	*httptest.Server
+	calls   atomic.Int32
+	sends   atomic.Int32
+	failing atomic.Bool
+}
+
+func newPoolTestServer(slot uint) *poolTestServer {
+	s := &poolTestServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcReq
+		json.NewDecoder(r.Body).Decode(&req)
+		switch req.Method {
+		case "getHealth":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":"ok"}`, req.ID)
+			return
+		case "sendTransaction":
+			s.sends.Add(1)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":"sig"}`, req.ID)
+			return
+		}
+		s.calls.Add(1)
+		if s.failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":%d}`, req.ID, slot)
+	}))
+	return s
+}
+
+func TestPoolRoundRobin(t *testing.T) {
+	first, second := newPoolTestServer(1), newPoolTestServer(1)
+	defer first.Close()
+	defer second.Close()
+
+	pool, err := NewPool([]solana.RpcEndpoint{solana.RpcEndpoint(first.URL), solana.RpcEndpoint(second.URL)}, WithHealthCheck(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	for i := 0; i < 10; i++ {
+		if _, err := pool.GetSlot(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first.calls.Load() != 5 || second.calls.Load() != 5 {
+		t.Fatal("Expected calls to be spread evenly", first.calls.Load(), second.calls.Load())
+	}
+}
+
+func TestPoolFailover(t *testing.T) {
+	first, second := newPoolTestServer(1), newPoolTestServer(2)
+	defer first.Close()
+	defer second.Close()
+	first.failing.Store(true)
+
+	pool, err := NewPool([]solana.RpcEndpoint{solana.RpcEndpoint(first.URL), solana.RpcEndpoint(second.URL)}, WithHealthCheck(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	for i := 0; i < 4; i++ {
+		slot, err := pool.GetSlot(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slot != 2 {
+			t.Fatal("Expected the healthy node to answer, got slot", slot)
+		}
+	}
+	if first.calls.Load() != 1 {
+		t.Fatal("Expected the failing node to be taken out of rotation, got calls", first.calls.Load())
+	}
+	if nodes := pool.Nodes(); nodes[0].Healthy || !nodes[1].Healthy {
+		t.Fatal("Unexpected node status", nodes)
+	}
+}
+
+func TestPoolHealthCheckSlotLag(t *testing.T) {
+	ahead, behind := newPoolTestServer(100), newPoolTestServer(10)
+	defer ahead.Close()
+	defer behind.Close()
+
+	pool, err := NewPool([]solana.RpcEndpoint{solana.RpcEndpoint(behind.URL), solana.RpcEndpoint(ahead.URL)}, WithHealthCheck(time.Hour, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+
+	//The first check runs right away in the background
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Nodes()[1].Slot == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	nodes := pool.Nodes()
+	if nodes[0].Healthy || !nodes[1].Healthy || nodes[0].Slot != 10 || nodes[1].Slot != 100 {
+		t.Fatal("Expected the lagging node to be taken out of rotation", nodes)
+	}
+	for i := 0; i < 3; i++ {
+		if slot, err := pool.GetSlot(context.Background()); err != nil || slot != 100 {
+			t.Fatal("Expected the node ahead to answer", slot, err)
+		}
+	}
+}
+
+func TestPoolSendFanOut(t *testing.T) {
+	first, second := newPoolTestServer(1), newPoolTestServer(1)
+	defer first.Close()
+	defer second.Close()
+
+	pool, err := NewPool([]solana.RpcEndpoint{solana.RpcEndpoint(first.URL), solana.RpcEndpoint(second.URL)}, WithHealthCheck(0, 0), WithSendFanOut())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	signature, err := pool.SendTransaction(context.Background(), "tx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature != "sig" {
+		t.Fatal("Unexpected signature", signature)
+	}
+	//The losing request may still be in flight
+	deadline := time.Now().Add(5 * time.Second)
+	for first.sends.Load()+second.sends.Load() != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if first.sends.Load() != 1 || second.sends.Load() != 1 {
+		t.Fatal("Expected the transaction to be sent to every node", first.sends.Load(), second.sends.Load())
+	}
+}
+
+func TestIntercept(t *testing.T) {
+	server := newPoolTestServer(3)
+	defer server.Close()
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL))
+
+	var methods []string
+	rpc := Intercept(func(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error {
+		methods = append(methods, method)
+		return call(ctx, client)
+	})
+	slot, err := rpc.GetSlot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 3 || len(methods) != 1 || methods[0] != "getSlot" {
+		t.Fatal("Unexpected interception", slot, methods)
+	}
+}
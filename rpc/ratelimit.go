@@ -0,0 +1,235 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+var _ solana.RpcContext = (*RateLimiter)(nil)
+
+// Limits the calls made through a solana.RpcContext, so the limits of an endpoint are respected before it answers with 429.
+// Calls wait in first-in first-out order: first for the limit of their method, if any, then for the overall limit and a free in-flight slot.
+// A call waiting for a tightly limited method does not hold up calls to other methods. RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	*interceptor
+
+	rpc      solana.RpcContext
+	overall  *limitQueue
+	methods  map[string]*limitQueue
+	inFlight chan struct{}
+}
+
+// Configures a RateLimiter.
+type RateLimitOption func(*RateLimiter)
+
+// Limits all calls to rate per second, allowing bursts of up to burst calls. A rate of zero or less means no limit.
+func WithRateLimit(rate float64, burst int) RateLimitOption {
+	return func(l *RateLimiter) {
+		if rate <= 0 {
+			l.overall.bucket = nil
+			return
+		}
+		l.overall.bucket = newTokenBucket(rate, burst)
+	}
+}
+
+// Limits the calls to a JSON-RPC method, e.g. "getProgramAccounts", to rate per second, allowing bursts of up to burst calls. Calls to the method also count towards the overall limit.
+// A rate of zero or less means no limit for the method.
+func WithMethodRateLimit(method string, rate float64, burst int) RateLimitOption {
+	return func(l *RateLimiter) {
+		if rate <= 0 {
+			delete(l.methods, method)
+			return
+		}
+		l.methods[method] = &limitQueue{bucket: newTokenBucket(rate, burst)}
+	}
+}
+
+// Limits the number of calls in flight at the same time. Zero or less means no limit.
+func WithMaxInFlight(max int) RateLimitOption {
+	return func(l *RateLimiter) {
+		if max <= 0 {
+			l.inFlight = nil
+			return
+		}
+		l.inFlight = make(chan struct{}, max)
+	}
+}
+
+// Returns rpc wrapped with the given limits. Use solana.ContextRpc and solana.BackgroundRpc to wrap a plain solana.Rpc.
+func NewRateLimiter(rpc solana.RpcContext, opts ...RateLimitOption) *RateLimiter {
+	limiter := &RateLimiter{
+		rpc:     rpc,
+		overall: &limitQueue{},
+		methods: map[string]*limitQueue{},
+	}
+	limiter.interceptor = &interceptor{handler: limiter.handle}
+	for _, opt := range opts {
+		opt(limiter)
+	}
+	return limiter
+}
+
+func (l *RateLimiter) handle(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error {
+	if err := l.acquire(ctx, method); err != nil {
+		return err
+	}
+	if l.inFlight != nil {
+		defer func() { <-l.inFlight }()
+	}
+	return call(ctx, l.rpc)
+}
+
+// Waits until the call may be made. On success an in-flight slot is held, if in-flight calls are limited.
+func (l *RateLimiter) acquire(ctx context.Context, method string) error {
+	queue, limited := l.methods[method]
+	if limited {
+		if err := queue.wait(ctx, nil); err != nil {
+			return err
+		}
+	}
+	if err := l.overall.wait(ctx, l.inFlight); err != nil {
+		//The call is not made, so it does not count towards the method limit
+		if limited {
+			queue.refund()
+		}
+		return err
+	}
+	return nil
+}
+
+// A token bucket with a FIFO queue in front of it. Only the caller at the head of the queue takes tokens, so callers are served in the order they arrived.
+type limitQueue struct {
+	bucket *tokenBucket //nil if unlimited
+	queue  fifoMutex
+}
+
+// Waits for a token and, if inFlight is set, for a free slot in it. The token is returned if ctx is done first.
+func (q *limitQueue) wait(ctx context.Context, inFlight chan struct{}) error {
+	if q.bucket == nil && inFlight == nil {
+		return ctx.Err()
+	}
+	if err := q.queue.lock(ctx); err != nil {
+		return err
+	}
+	defer q.queue.unlock()
+
+	if q.bucket != nil {
+		if err := q.bucket.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if inFlight != nil {
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+			q.refund()
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Gives back a token taken by wait, for a call that is not made.
+func (q *limitQueue) refund() {
+	if q.bucket != nil {
+		q.bucket.refund()
+	}
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 //Tokens added per second
+	burst  float64 //Maximum number of tokens
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(max(burst, 1)), tokens: float64(max(burst, 1)), last: time.Now()}
+}
+
+// Takes a token, waiting until one is available. The token is returned if ctx is done first.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.refund()
+		return ctx.Err()
+	}
+}
+
+func (b *tokenBucket) refund() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// A mutex handing the lock to waiters in the order they arrived, which sync.Mutex does not guarantee.
+type fifoMutex struct {
+	mu      sync.Mutex
+	locked  bool
+	waiters []chan struct{}
+}
+
+func (m *fifoMutex) lock(ctx context.Context) error {
+	m.mu.Lock()
+	if !m.locked {
+		m.locked = true
+		m.mu.Unlock()
+		return nil
+	}
+	ch := make(chan struct{})
+	m.waiters = append(m.waiters, ch)
+	m.mu.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		for i, waiter := range m.waiters {
+			if waiter == ch {
+				m.waiters = append(m.waiters[:i], m.waiters[i+1:]...)
+				m.mu.Unlock()
+				return ctx.Err()
+			}
+		}
+		m.mu.Unlock()
+		//The lock was handed over in the meantime, pass it on
+		m.unlock()
+		return ctx.Err()
+	}
+}
+
+func (m *fifoMutex) unlock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.waiters) == 0 {
+		m.locked = false
+		return
+	}
+	next := m.waiters[0]
+	m.waiters = m.waiters[1:]
+	close(next)
+}
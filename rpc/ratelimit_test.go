@@ -0,0 +1,200 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Returns a client answering every call instantly without making a request.
+func newNopRpc() solana.RpcContext {
+	return Intercept(func(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error {
+		return nil
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := NewRateLimiter(newNopRpc(), WithRateLimit(100, 1))
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := limiter.GetSlot(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Fatal("Expected calls to be spaced out, took", elapsed)
+	}
+}
+
+func TestMethodRateLimit(t *testing.T) {
+	limiter := NewRateLimiter(newNopRpc(), WithMethodRateLimit("getProgramAccounts", 0.1, 1))
+	program := solana.MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	if _, err := limiter.GetProgramAccounts(context.Background(), program); err != nil {
+		t.Fatal(err)
+	}
+
+	blocked := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := limiter.GetProgramAccounts(ctx, program)
+		blocked <- err
+	}()
+
+	//Other methods are not held up by the exhausted method limit
+	for i := 0; i < 10; i++ {
+		if _, err := limiter.GetSlot(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := <-blocked; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected the second call to wait for the method limit, got", err)
+	}
+}
+
+func TestMaxInFlight(t *testing.T) {
+	var current, highest atomic.Int32
+	rpc := Intercept(func(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error {
+		n := current.Add(1)
+		for {
+			h := highest.Load()
+			if n <= h || highest.CompareAndSwap(h, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		current.Add(-1)
+		return nil
+	})
+	limiter := NewRateLimiter(rpc, WithMaxInFlight(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := limiter.GetSlot(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if highest.Load() > 2 {
+		t.Fatal("Expected at most 2 calls in flight, got", highest.Load())
+	}
+}
+
+func TestMaxInFlightUnlimited(t *testing.T) {
+	limiter := NewRateLimiter(newNopRpc(), WithMaxInFlight(0))
+	done := make(chan error)
+	go func() {
+		_, err := limiter.GetSlot(context.Background())
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected no in-flight limit")
+	}
+}
+
+func TestRateLimitUnlimited(t *testing.T) {
+	limiter := NewRateLimiter(newNopRpc(), WithRateLimit(0, 1), WithMethodRateLimit("getSlot", -1, 1))
+	if limiter.overall.bucket != nil || len(limiter.methods) != 0 {
+		t.Fatal("Expected no token bucket for a non-positive rate")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 10; i++ {
+		if _, err := limiter.GetSlot(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMethodRateLimitRefund(t *testing.T) {
+	release := make(chan struct{})
+	rpc := Intercept(func(ctx context.Context, method string, call func(ctx context.Context, rpc solana.RpcContext) error) error {
+		if method == "getSlot" {
+			<-release
+		}
+		return nil
+	})
+	limiter := NewRateLimiter(rpc, WithMethodRateLimit("getProgramAccounts", 0.1, 1), WithMaxInFlight(1))
+	program := solana.MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+
+	//Hold the only in-flight slot
+	done := make(chan struct{})
+	go func() {
+		limiter.GetSlot(context.Background())
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.GetProgramAccounts(ctx, program); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected the call to wait for the in-flight slot, got", err)
+	}
+	close(release)
+	<-done
+
+	//The canceled call gave its method token back
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.GetProgramAccounts(ctx, program); err != nil {
+		t.Fatal("Expected the method token to be given back, got", err)
+	}
+}
+
+func TestFifoMutex(t *testing.T) {
+	var m fifoMutex
+	m.lock(context.Background())
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.lock(context.Background())
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			m.unlock()
+		}()
+		//Wait for the goroutine to queue up before starting the next one
+		for {
+			m.mu.Lock()
+			queued := len(m.waiters)
+			m.mu.Unlock()
+			if queued == i+1 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.lock(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatal("Expected cancelled lock to fail, got", err)
+	}
+
+	m.unlock()
+	wg.Wait()
+	for i, n := range order {
+		if n != i {
+			t.Fatal("Expected waiters to be served in order, got", order)
+		}
+	}
+}
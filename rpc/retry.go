@@ -0,0 +1,251 @@
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+	"github.com/mr-tron/base58"
+)
+
+// Some providers return rate limits as a JSON-RPC error instead of an HTTP status code
+const errorCodeTooManyRequests solana.ErrorCode = 429
+
+// Decides when and how failed requests are retried.
+type RetryPolicy struct {
+	MaxAttempts    int           //Total number of attempts including the first one. Values below 2 disable retries.
+	InitialBackoff time.Duration //Delay before the first retry
+	MaxBackoff     time.Duration //Upper bound of the computed delay. A longer Retry-After requested by the server is still honoured.
+	Multiplier     float64       //Factor the delay grows by after every attempt
+	Jitter         float64       //Fraction of the delay that is randomized, between 0 and 1
+	Budget         *RetryBudget  //Optional budget limiting the ratio of retries to requests. May be shared between clients.
+
+	Idempotent func(method string) bool //Reports whether a method can be retried blindly. Defaults to every method except sendTransaction and requestAirdrop. sendTransaction is only retried once getSignatureStatuses shows the transaction has not landed.
+	Retryable  func(err error) bool     //Reports whether an error is worth retrying. Defaults to IsRetryable.
+	OnRetry    func(event RetryEvent)   //Called before every retry, e.g. for logging
+}
+
+// Describes a failed attempt that is about to be retried.
+type RetryEvent struct {
+	Method  string        //The RPC method being called
+	Attempt int           //The attempt that failed, starting at 1
+	Err     error         //The error returned by the failed attempt
+	Delay   time.Duration //How long the client waits before the next attempt
+}
+
+// Returns the policy used by clients unless WithRetryPolicy is given.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// Sets the retry policy of the client. Use RetryPolicy{} to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(r *RpcClient) {
+		r.retryPolicy = &policy
+	}
+}
+
+func (p *RetryPolicy) idempotent(method string) bool {
+	if p.Idempotent != nil {
+		return p.Idempotent(method)
+	}
+	return method != "sendTransaction" && method != "requestAirdrop"
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+func (p *RetryPolicy) backoff(attempt int, err error) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(max(p.Multiplier, 1), float64(attempt-1))
+	if p.MaxBackoff > 0 {
+		delay = min(delay, float64(p.MaxBackoff))
+	}
+	if p.Jitter > 0 {
+		jitter := min(p.Jitter, 1)
+		delay = delay * (1 - jitter + 2*jitter*rand.Float64())
+	}
+
+	var retryAfter interface{ retryAfterDelay() time.Duration }
+	if errors.As(err, &retryAfter) {
+		return max(time.Duration(delay), retryAfter.retryAfterDelay())
+	}
+	return time.Duration(delay)
+}
+
+// Limits retries to a ratio of the requests made, so a struggling endpoint is not flooded with retries. Every request deposits ratio tokens and every retry withdraws one.
+type RetryBudget struct {
+	mu     sync.Mutex
+	ratio  float64
+	max    float64
+	tokens float64
+}
+
+// Creates a budget allowing ratio retries per request, holding at most max unused retries. The budget starts full.
+func NewRetryBudget(ratio float64, max int) *RetryBudget {
+	return &RetryBudget{ratio: ratio, max: float64(max), tokens: float64(max)}
+}
+
+func (b *RetryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.tokens+b.ratio, b.max)
+}
+
+func (b *RetryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Returned when the endpoint responds with a non-200 status code and no JSON-RPC error.
+type HTTPError struct {
+	StatusCode int           //HTTP status code of the response
+	RetryAfter time.Duration //Delay requested through the Retry-After header, zero if none
+	Body       string        //Raw response body
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("rpc request failed. Status code: %d", e.StatusCode)
+}
+
+// Too many requests and server side failures can succeed on a later attempt.
+func (e *HTTPError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+func (e *HTTPError) retryAfterDelay() time.Duration {
+	return e.RetryAfter
+}
+
+// Rate limits, unhealthy nodes and nodes that are behind the requested slot can succeed on a later attempt.
+func (e *rpcError) Retryable() bool {
+	switch e.Code {
+	case errorCodeTooManyRequests,
+		solana.SOLANA_ERROR__JSON_RPC__SERVER_ERROR_NODE_UNHEALTHY,
+		solana.SOLANA_ERROR__JSON_RPC__SERVER_ERROR_BLOCK_STATUS_NOT_AVAILABLE_YET,
+		solana.SOLANA_ERROR__JSON_RPC__SERVER_ERROR_MIN_CONTEXT_SLOT_NOT_REACHED:
+		return true
+	}
+	return false
+}
+
+func (e *rpcError) retryAfterDelay() time.Duration {
+	return e.retryAfter
+}
+
+// Reports whether a request that failed with err may succeed if retried. This covers rate limits, 5xx responses, temporary node errors and network failures such as connection resets.
+// Context cancellation is never retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var retryable interface{ Retryable() bool }
+	if errors.As(err, &retryable) {
+		return retryable.Retryable()
+	}
+	//Every transport failure is wrapped in a *url.Error which itself is a net.Error, so look at the underlying cause
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0)
+	}
+	return 0
+}
+
+// Checks whether the transaction sent with the given sendTransaction params was processed. If it was, its signature is decoded into res.
+func (r *RpcClient) transactionLanded(ctx context.Context, params any, res interface{}) (bool, error) {
+	signature, err := sendTransactionSignature(params)
+	if err != nil {
+		return false, err
+	}
+
+	var statuses struct {
+		Value []*solana.SignatureStatus `json:"value"`
+	}
+	if err := r.sendOnce(ctx, "getSignatureStatuses", []interface{}{[]string{signature}}, &statuses); err != nil {
+		return false, err
+	}
+	if len(statuses.Value) == 0 || statuses.Value[0] == nil {
+		return false, nil
+	}
+
+	data, err := json.Marshal(signature)
+	if err != nil {
+		return false, err
+	}
+	return true, decodeResult(data, res)
+}
+
+// Extracts the first signature of the encoded transaction passed to sendTransaction, which is the transaction's id.
+func sendTransactionSignature(params any) (string, error) {
+	args, ok := params.([]interface{})
+	if !ok || len(args) == 0 {
+		return "", errors.New("missing transaction")
+	}
+	encodedTx, ok := args[0].(string)
+	if !ok {
+		return "", errors.New("invalid transaction")
+	}
+
+	//sendTransaction defaults to base58 when no encoding is given
+	encoding := solana.EncodingBase58
+	if len(args) > 1 {
+		if config, ok := args[1].(solana.SendTransactionConfig); ok && config.Encoding != nil {
+			encoding = *config.Encoding
+		}
+	}
+	var data []byte
+	var err error
+	if encoding == solana.EncodingBase64 {
+		data, err = base64.StdEncoding.DecodeString(encodedTx)
+	} else {
+		data, err = base58.Decode(encodedTx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) < 65 || data[0] == 0 {
+		return "", errors.New("transaction has no signature")
+	}
+	return base58.Encode(data[1:65]), nil
+}
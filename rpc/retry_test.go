@@ -0,0 +1,211 @@
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+	"github.com/mr-tron/base58"
+)
+
+func testRetryPolicy(events *[]RetryEvent) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+		OnRetry: func(event RetryEvent) {
+			*events = append(*events, event)
+		},
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":2,"error":{"code":-32016,"message":"Minimum context slot has not been reached"}}`))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":3,"result":9}`))
+		}
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithRetryPolicy(testRetryPolicy(&events)))
+	slot, err := client.GetSlot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 9 {
+		t.Fatal("Unexpected slot", slot)
+	}
+	if len(events) != 2 {
+		t.Fatal("Expected 2 retries, got", len(events))
+	}
+	var httpErr *HTTPError
+	if !errors.As(events[0].Err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatal("Unexpected first error", events[0].Err)
+	}
+	if events[0].Method != "getSlot" || events[1].Attempt != 2 {
+		t.Fatal("Unexpected retry event", events[1])
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithRetryPolicy(testRetryPolicy(&events)))
+	_, err := client.GetSlot(context.Background())
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatal("Expected HTTP 429 error, got", err)
+	}
+	if !IsRetryable(err) {
+		t.Fatal("Expected HTTP 429 to be retryable")
+	}
+	if calls != 3 {
+		t.Fatal("Expected 3 attempts, got", calls)
+	}
+}
+
+func TestRetrySkipsPermanentErrors(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"Invalid params"}}`))
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithRetryPolicy(testRetryPolicy(&events)))
+	if _, err := client.GetSlot(context.Background()); err == nil {
+		t.Fatal("Expected error")
+	}
+	if calls != 1 {
+		t.Fatal("Expected a single attempt, got", calls)
+	}
+}
+
+func TestRetryRequestAirdropNotRetried(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithRetryPolicy(testRetryPolicy(&events)))
+	if _, err := client.RequestAirdrop(context.Background(), solana.MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY"), 1); err == nil {
+		t.Fatal("Expected error")
+	}
+	if calls != 1 {
+		t.Fatal("Expected a single attempt, got", calls)
+	}
+}
+
+func TestRetrySendTransaction(t *testing.T) {
+	signature := make([]byte, 64)
+	signature[0] = 1
+	txData := append([]byte{1}, signature...)
+	txData = append(txData, 0, 0, 0)
+	encodedTx := base64.StdEncoding.EncodeToString(txData)
+	expected := base58.Encode(signature)
+
+	for _, landed := range []bool{true, false} {
+		t.Run(fmt.Sprintf("landed=%v", landed), func(t *testing.T) {
+			var sends, statusChecks int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req rpcReq
+				json.NewDecoder(r.Body).Decode(&req)
+				switch req.Method {
+				case "sendTransaction":
+					sends++
+					if sends == 1 {
+						w.WriteHeader(http.StatusServiceUnavailable)
+						return
+					}
+					fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":"%s"}`, req.ID, expected)
+				case "getSignatureStatuses":
+					statusChecks++
+					if landed {
+						fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":{"context":{"slot":1},"value":[{"slot":1,"confirmations":0,"err":null,"confirmationStatus":"processed"}]}}`, req.ID)
+					} else {
+						fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":{"context":{"slot":1},"value":[null]}}`, req.ID)
+					}
+				}
+			}))
+			defer server.Close()
+
+			var events []RetryEvent
+			client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithRetryPolicy(testRetryPolicy(&events)))
+			encoding := solana.EncodingBase64
+			res, err := client.SendTransaction(context.Background(), encodedTx, solana.SendTransactionConfig{Encoding: &encoding})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != expected {
+				t.Fatal("Unexpected signature", res)
+			}
+			if statusChecks != 1 {
+				t.Fatal("Expected a signature status check, got", statusChecks)
+			}
+			if landed && sends != 1 {
+				t.Fatal("Expected transaction not to be resent, got", sends)
+			}
+			if !landed && sends != 2 {
+				t.Fatal("Expected transaction to be resent, got", sends)
+			}
+		})
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	policy := testRetryPolicy(&events)
+	policy.Budget = NewRetryBudget(0, 1)
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL), WithRetryPolicy(policy))
+	client.GetSlot(context.Background())
+	client.GetSlot(context.Background())
+	if calls != 3 {
+		t.Fatal("Expected the budget to allow a single retry, got", calls-2)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if parseRetryAfter("3") != 3*time.Second {
+		t.Fatal("Unexpected delay for seconds value")
+	}
+	if parseRetryAfter("") != 0 || parseRetryAfter("soon") != 0 {
+		t.Fatal("Expected no delay for missing or invalid values")
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if parseRetryAfter(date) < 59*time.Minute {
+		t.Fatal("Unexpected delay for date value")
+	}
+}
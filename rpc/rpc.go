@@ -1,37 +1,66 @@
 package rpc
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/hwsimmons17/solana-web3.go"
 )
 
+var _ solana.RpcContext = (*RpcClient)(nil)
+
+// Client for the HTTP JSON-RPC API of a node.
+// Every method of RpcClient is safe for concurrent use by multiple goroutines. Request IDs are unique across goroutines and connections of the underlying http.Client are reused between them.
+// Callbacks given as options, such as WithHeaderFunc and RetryPolicy.OnRetry, may be called concurrently. Endpoint must not be changed once the client is in use.
 type RpcClient struct {
 	Endpoint solana.RpcEndpoint
-	ID       int
+
+	lastID      atomic.Int64 //ID of the last request, incremented atomically for every request
+	httpClient  *http.Client
+	headers     http.Header
+	headerFuncs []func(ctx context.Context, header http.Header)
+	gzip        bool
+	retryPolicy *RetryPolicy
+}
+
+// Returns a client implementing the context-free solana.Rpc interface. Every call is made with context.Background(), use NewContextRpcClient for cancellation and deadlines.
+func NewRpcClient(endpoint solana.RpcEndpoint, opts ...Option) solana.Rpc {
+	return solana.BackgroundRpc(NewContextRpcClient(endpoint, opts...))
 }
 
-func NewRpcClient(endpoint solana.RpcEndpoint) solana.Rpc {
-	return &RpcClient{Endpoint: endpoint, ID: 1}
+// Returns a client implementing solana.RpcContext, where every method takes a context.Context that is attached to the underlying HTTP request.
+func NewContextRpcClient(endpoint solana.RpcEndpoint, opts ...Option) *RpcClient {
+	retryPolicy := DefaultRetryPolicy()
+	client := &RpcClient{
+		Endpoint:    endpoint,
+		httpClient:  &http.Client{},
+		headers:     http.Header{},
+		retryPolicy: &retryPolicy,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
 }
 
-func NewRpcClientWithHealthCheck(endpoint solana.RpcEndpoint) (solana.Rpc, error) {
-	client := &RpcClient{Endpoint: endpoint}
+func NewRpcClientWithHealthCheck(endpoint solana.RpcEndpoint, opts ...Option) (solana.Rpc, error) {
+	client := NewContextRpcClient(endpoint, opts...)
 
-	if err := client.GetHealth(); err != nil {
+	if err := client.GetHealth(context.Background()); err != nil {
 		return nil, err
 	}
 
-	return &RpcClient{Endpoint: endpoint}, nil
+	return solana.BackgroundRpc(client), nil
 }
 
-func (r *RpcClient) GetHealth() error {
+func (r *RpcClient) GetHealth(ctx context.Context) error {
 	var res string
-	if err := r.send("getHealth", nil, &res); err != nil {
+	if err := r.send(ctx, "getHealth", nil, &res); err != nil {
 		return err
 	}
 
@@ -42,41 +71,42 @@ func (r *RpcClient) GetHealth() error {
 	return nil
 }
 
-func (r *RpcClient) GetIdentity() (solana.Pubkey, error) {
+func (r *RpcClient) GetIdentity(ctx context.Context) (solana.Pubkey, error) {
 	var res struct {
 		Identity solana.PubkeyStr `json:"identity"`
 	}
-	if err := r.send("getIdentity", nil, &res); err != nil {
+	if err := r.send(ctx, "getIdentity", nil, &res); err != nil {
 		return nil, err
 	}
 
 	return &res.Identity, nil
 }
 
-func (r *RpcClient) GetVersion() (solana.Version, error) {
+func (r *RpcClient) GetVersion(ctx context.Context) (solana.Version, error) {
 	var res solana.Version
-	if err := r.send("getVersion", nil, &res); err != nil {
+	if err := r.send(ctx, "getVersion", nil, &res); err != nil {
 		return solana.Version{}, err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) RequestAirdrop(destinationAddress solana.Pubkey, lamports uint, config ...solana.StandardCommitmentConfig) (string, error) {
+func (r *RpcClient) RequestAirdrop(ctx context.Context, destinationAddress solana.Pubkey, lamports uint, config ...solana.StandardCommitmentConfig) (string, error) {
 	params := []interface{}{destinationAddress.String(), lamports}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
 	var res string
-	if err := r.send("requestAirdrop", params, &res); err != nil {
+	if err := r.send(ctx, "requestAirdrop", params, &res); err != nil {
 		return "", err
 	}
 
 	return res, nil
 }
 
-func (r *RpcClient) incrementID() {
-	r.ID++
+// Returns a request ID no other request of the client uses.
+func (r *RpcClient) nextID() int {
+	return int(r.lastID.Add(1))
 }
 
 type rpcReq struct {
@@ -87,68 +117,169 @@ type rpcReq struct {
 }
 
 type rpcResp struct {
-	Jsonrpc string      `json:"jsonrpc"`
-	ID      int         `json:"id"`
-	Result  interface{} `json:"result"`
-	Error   *struct {
-		Code    int         `json:"code"`
-		Message string      `json:"message"`
-		Data    interface{} `json:"data"`
-	} `json:"error"`
-}
-
-func (r *RpcClient) send(method string, params any, res interface{}) error {
-	body := rpcReq{
-		ID:      r.ID,
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonRpcError   `json:"error"`
+}
+
+type jsonRpcError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Error returned by the RPC node. It unwraps to a *solana.SolanaError, so it can be matched against the solana.ErrRpc* sentinels with errors.Is.
+type rpcError struct {
+	*solana.SolanaError
+	retryAfter time.Duration
+}
+
+func (e *rpcError) Unwrap() error {
+	return e.SolanaError
+}
+
+func newRpcError(e *jsonRpcError, retryAfter time.Duration) *rpcError {
+	err := solana.NewSolanaError(solana.ErrorCode(e.Code), e.Message)
+	if len(e.Data) > 0 {
+		json.Unmarshal(e.Data, &err.Data)
+	}
+	//A failed preflight check carries the result of the simulation, so expose the reason the transaction failed
+	if err.Code == solana.SOLANA_ERROR__JSON_RPC__SERVER_ERROR_SEND_TRANSACTION_PREFLIGHT_FAILURE {
+		var simulation struct {
+			Err           *solana.TransactionError `json:"err"`
+			Logs          []string                 `json:"logs"`
+			UnitsConsumed *uint                    `json:"unitsConsumed"`
+		}
+		if json.Unmarshal(e.Data, &simulation) == nil {
+			if simulation.Err != nil {
+				err.Cause = simulation.Err
+			}
+			err.Context = map[string]any{"logs": simulation.Logs}
+			if simulation.UnitsConsumed != nil {
+				err.Context["unitsConsumed"] = *simulation.UnitsConsumed
+			}
+		}
+	}
+	return &rpcError{SolanaError: err, retryAfter: retryAfter}
+}
+
+func (r *RpcClient) newRequest(method string, params any) rpcReq {
+	return rpcReq{
+		ID:      r.nextID(),
 		Jsonrpc: "2.0",
 		Method:  method,
 		Params:  params,
 	}
-	r.incrementID()
+}
 
-	data, err := json.Marshal(body)
-	if err != nil {
-		return err
+func (r *RpcClient) send(ctx context.Context, method string, params any, res interface{}) error {
+	policy := r.retryPolicy
+	if policy == nil {
+		policy = &RetryPolicy{}
+	}
+	if policy.Budget != nil {
+		policy.Budget.deposit()
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := r.sendOnce(ctx, method, params, res)
+		if err == nil || attempt >= policy.MaxAttempts || !policy.retryable(err) || ctx.Err() != nil {
+			return err
+		}
+		if !policy.idempotent(method) {
+			//Only resend a transaction once we know the previous attempt did not land
+			if method != "sendTransaction" {
+				return err
+			}
+			landed, checkErr := r.transactionLanded(ctx, params, res)
+			if checkErr != nil {
+				return err
+			}
+			if landed {
+				return nil
+			}
+		}
+		if policy.Budget != nil && !policy.Budget.withdraw() {
+			return err
+		}
+
+		delay := policy.backoff(attempt, err)
+		if policy.OnRetry != nil {
+			policy.OnRetry(RetryEvent{Method: method, Attempt: attempt, Err: err, Delay: delay})
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
+}
 
-	client := http.Client{}
-	req, err := http.NewRequest(http.MethodPost, string(r.Endpoint), bytes.NewBuffer([]byte(data)))
+func (r *RpcClient) sendOnce(ctx context.Context, method string, params any, res interface{}) error {
+	resp, err := r.post(ctx, r.newRequest(method, params))
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	defer resp.Body.Close()
 
-	resp, err := client.Do(req)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+
 	var result rpcResp
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(body, &result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return &HTTPError{StatusCode: resp.StatusCode, RetryAfter: retryAfter, Body: string(body)}
+		}
 		return err
 	}
 
 	if result.Error != nil {
-		if result.Error.Code == 429 {
-			retryAfter, err := time.ParseDuration(resp.Header.Get("Retry-After") + "s")
-			if err == nil {
-				time.Sleep(retryAfter)
-				return r.send(method, params, res)
-			}
-		}
-		return fmt.Errorf("rpc request failed. Code: %d, Message: %s, Data: %v", result.Error.Code, result.Error.Message, result.Error.Data)
+		return newRpcError(result.Error, retryAfter)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("rpc request failed. Status code: %d", resp.StatusCode)
+		return &HTTPError{StatusCode: resp.StatusCode, RetryAfter: retryAfter, Body: string(body)}
 	}
 
-	resJson, err := json.Marshal(result.Result)
+	return decodeResult(result.Result, res)
+}
+
+// Posts the JSON encoding of body to the endpoint. The caller is responsible for closing the response body.
+func (r *RpcClient) post(ctx context.Context, body any) (*http.Response, error) {
+	data, err := json.Marshal(body)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if err := json.Unmarshal(resJson, res); err != nil {
-		return err
+
+	req, err := r.newHTTPRequest(ctx, data)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	client := r.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := decompressResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func decodeResult(result json.RawMessage, res interface{}) error {
+	//A missing result is treated the same as a null one
+	if len(result) == 0 {
+		result = json.RawMessage("null")
+	}
+	return json.Unmarshal(result, res)
 }
@@ -1,7 +1,17 @@
 package rpc
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hwsimmons17/solana-web3.go"
 )
@@ -51,3 +61,172 @@ func TestRequestAirdrop(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSendContextDeadline(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetSlot(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected deadline exceeded error, got", err)
+	}
+}
+
+func TestSendRetryAfterRespectsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":429,"message":"Too many requests"}}`))
+	}))
+	defer server.Close()
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.GetSlot(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected deadline exceeded error, got", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatal("Retry sleep did not respect context cancellation")
+	}
+}
+
+func TestBackgroundRpc(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":42}`))
+	}))
+	defer server.Close()
+
+	client := NewRpcClient(solana.RpcEndpoint(server.URL))
+	slot, err := client.GetSlot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 42 {
+		t.Fatal("Unexpected slot", slot)
+	}
+	if _, ok := solana.ContextRpc(client).(*RpcClient); !ok {
+		t.Fatal("Expected ContextRpc to unwrap the RpcClient")
+	}
+}
+
+func TestPreflightFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32002,"message":"Transaction simulation failed: Blockhash not found","data":{"err":"BlockhashNotFound","logs":["Program log: hello"],"unitsConsumed":0}}}`))
+	}))
+	defer server.Close()
+
+	client := NewContextRpcClient(solana.RpcEndpoint(server.URL))
+	_, err := client.SendTransaction(context.Background(), "tx")
+	if !errors.Is(err, solana.ErrRpcSendTransactionPreflightFailure) {
+		t.Fatal("Expected preflight failure, got", err)
+	}
+	if !errors.Is(err, solana.ErrTransactionBlockhashNotFound) {
+		t.Fatal("Expected blockhash not found, got", err)
+	}
+	var solanaErr *solana.SolanaError
+	if !errors.As(err, &solanaErr) {
+		t.Fatal("Expected a SolanaError")
+	}
+	if logs := solanaErr.Context["logs"].([]string); len(logs) != 1 || logs[0] != "Program log: hello" {
+		t.Fatal("Unexpected logs", solanaErr.Context["logs"])
+	}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	ids := map[int]bool{}
+	var duplicates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var reqs []rpcReq
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			var req rpcReq
+			json.Unmarshal(body, &req)
+			reqs = []rpcReq{req}
+		}
+
+		mu.Lock()
+		for _, req := range reqs {
+			if ids[req.ID] {
+				duplicates++
+			}
+			ids[req.ID] = true
+		}
+		mu.Unlock()
+
+		var results []string
+		for _, req := range reqs {
+			switch req.Method {
+			case "getHealth":
+				results = append(results, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":"ok"}`, req.ID))
+			case "getBalance":
+				results = append(results, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":{"context":{"slot":1},"value":%d}}`, req.ID, req.ID))
+			default:
+				results = append(results, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":%d}`, req.ID, req.ID))
+			}
+		}
+		if len(reqs) == 1 && body[0] != '[' {
+			w.Write([]byte(results[0]))
+			return
+		}
+		w.Write([]byte("[" + strings.Join(results, ",") + "]"))
+	}))
+	defer server.Close()
+
+	rpc, err := NewRpcClientWithHealthCheck(solana.RpcEndpoint(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := solana.ContextRpc(rpc).(*RpcClient)
+	pubkey := solana.MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY")
+
+	const goroutines, calls = 50, 20
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				switch j % 3 {
+				case 0:
+					if _, err := rpc.GetSlot(); err != nil {
+						t.Error(err)
+					}
+				case 1:
+					if err := client.GetHealth(context.Background()); err != nil {
+						t.Error(err)
+					}
+				case 2:
+					batch := client.NewBatch()
+					first, second := batch.GetBalance(pubkey), batch.GetBalance(pubkey)
+					if err := batch.Send(context.Background()); err != nil {
+						t.Error(err)
+						continue
+					}
+					a, _ := first.Result()
+					b, _ := second.Result()
+					if a == b {
+						t.Error("Expected distinct request IDs within a batch", a, b)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if duplicates != 0 {
+		t.Fatal("Expected unique request IDs, got duplicates", duplicates)
+	}
+	//One health check at construction, and two requests for every batch
+	expected := 1 + goroutines*(calls+calls/3)
+	if len(ids) != expected {
+		t.Fatal("Unexpected number of requests", len(ids), expected)
+	}
+}
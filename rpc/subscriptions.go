@@ -0,0 +1,847 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Returned by the calls of a closed WsClient, and by Subscription.Err once the client is closed.
+var ErrWsClientClosed = errors.New("websocket client closed")
+
+const (
+	defaultPingInterval       = 30 * time.Second
+	defaultReconnectBackoff   = 500 * time.Millisecond
+	defaultMaxReconnectDelay  = 30 * time.Second
+	defaultSubscriptionBuffer = 64
+)
+
+// Client for the PubSub WebSocket API of an RPC node, delivering notifications over Go channels.
+// When the connection drops the client dials again with exponential backoff and resubscribes every open subscription. Notifications sent while disconnected are lost.
+// WsClient is safe for concurrent use.
+type WsClient struct {
+	Endpoint string
+
+	header             http.Header
+	dialer             wsDialer
+	pingInterval       time.Duration
+	reconnectBackoff   time.Duration
+	maxReconnectDelay  time.Duration
+	subscriptionBuffer int
+
+	ctx    context.Context //Cancelled by Close
+	cancel context.CancelFunc
+
+	mu            sync.Mutex
+	conn          *wsConn
+	generation    int           //Incremented on every new connection, so responses can be matched to the connection they were sent on
+	ready         chan struct{} //Closed once conn is set
+	nextID        int
+	requests      map[int]*wsRequest
+	subscriptions map[*subscription]struct{}
+	active        map[int]*subscription //Subscriptions of the current connection, by the id the node assigned
+}
+
+// Configures a WsClient.
+type WsOption func(*WsClient)
+
+// Adds a header to the WebSocket handshake, e.g. the API key header of a paid RPC provider.
+func WithWsHeader(key, value string) WsOption {
+	return func(c *WsClient) {
+		c.header.Add(key, value)
+	}
+}
+
+// Dials the node through the proxy and with the TLS configuration of client's transport, if it is an *http.Transport, e.g. the client passed to WithHTTPClient.
+// By default the proxy is taken from the environment, as http.DefaultTransport does.
+func WithWsHTTPClient(client *http.Client) WsOption {
+	return func(c *WsClient) {
+		transport, ok := http.DefaultTransport.(*http.Transport)
+		if client != nil && client.Transport != nil {
+			transport, ok = client.Transport.(*http.Transport)
+		}
+		if ok {
+			c.dialer = wsDialer{proxy: transport.Proxy, tlsConfig: transport.TLSClientConfig}
+		}
+	}
+}
+
+// Sets how often the client pings the node. A connection that stays silent for two intervals is considered dead and replaced. Zero disables pings.
+func WithPingInterval(interval time.Duration) WsOption {
+	return func(c *WsClient) {
+		c.pingInterval = interval
+	}
+}
+
+// Sets the delay before the first reconnect attempt and the upper bound the delay doubles up to.
+func WithReconnectBackoff(initial, max time.Duration) WsOption {
+	return func(c *WsClient) {
+		c.reconnectBackoff = initial
+		c.maxReconnectDelay = max
+	}
+}
+
+// Sets how many notifications a subscription buffers. When a buffer is full the client waits for it to be drained, which delays every other subscription of the client.
+func WithSubscriptionBuffer(size int) WsOption {
+	return func(c *WsClient) {
+		c.subscriptionBuffer = size
+	}
+}
+
+// Connects to the PubSub endpoint of a node. HTTP endpoints are converted the same way solana-web3.js does it: http becomes ws, https becomes wss and an explicit port is incremented by one, so http://localhost:8899 becomes ws://localhost:8900.
+func NewWsClient(ctx context.Context, endpoint solana.RpcEndpoint, opts ...WsOption) (*WsClient, error) {
+	clientCtx, cancel := context.WithCancel(context.Background())
+	client := &WsClient{
+		Endpoint:           wsEndpoint(string(endpoint)),
+		header:             http.Header{},
+		dialer:             wsDialer{proxy: http.ProxyFromEnvironment},
+		pingInterval:       defaultPingInterval,
+		reconnectBackoff:   defaultReconnectBackoff,
+		maxReconnectDelay:  defaultMaxReconnectDelay,
+		subscriptionBuffer: defaultSubscriptionBuffer,
+		ctx:                clientCtx,
+		cancel:             cancel,
+		ready:              make(chan struct{}),
+		nextID:             1,
+		requests:           map[int]*wsRequest{},
+		subscriptions:      map[*subscription]struct{}{},
+		active:             map[int]*subscription{},
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	conn, err := client.dial(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	client.connected(conn)
+	go client.run(conn)
+	if client.pingInterval > 0 {
+		go client.keepAlive()
+	}
+	return client, nil
+}
+
+func wsEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return endpoint
+	}
+	if port, err := strconv.Atoi(u.Port()); err == nil {
+		u.Host = net.JoinHostPort(u.Hostname(), strconv.Itoa(port+1))
+	}
+	return u.String()
+}
+
+// Closes the connection and ends every subscription. Subscriptions report ErrWsClientClosed from Err.
+func (c *WsClient) Close() error {
+	c.cancel()
+	c.mu.Lock()
+	conn := c.conn
+	subscriptions := c.subscriptions
+	c.conn = nil
+	c.subscriptions = map[*subscription]struct{}{}
+	c.active = map[int]*subscription{}
+	c.mu.Unlock()
+
+	for sub := range subscriptions {
+		sub.end(ErrWsClientClosed)
+	}
+	if conn == nil {
+		return nil
+	}
+	return conn.close()
+}
+
+// Notification sent by accountSubscribe when the lamports or data of an account change.
+type AccountNotification struct {
+	Slot    uint            //The slot at which the change was observed
+	Account *solana.Account //The new state of the account
+}
+
+// Notification sent by programSubscribe when the lamports or data of an account owned by the program change.
+type ProgramNotification struct {
+	Slot    uint           //The slot at which the change was observed
+	Account solana.Account //The new state of the account
+}
+
+// Notification sent by logsSubscribe for every transaction matching the filter.
+type LogsNotification struct {
+	Slot      uint                     //The slot the transaction was processed in
+	Signature string                   //The transaction signature, as base-58 encoded string
+	Err       *solana.TransactionError //Error if transaction failed, nil if transaction succeeded
+	Logs      []string                 //Log messages the transaction instructions output during execution
+}
+
+// Notification sent by signatureSubscribe.
+type SignatureNotification struct {
+	Slot     uint                     //The slot the notification was sent at
+	Received bool                     //Set when the node received the transaction, only sent when EnableReceivedNotification is set. Err is always nil in this case.
+	Err      *solana.TransactionError //Error if transaction failed, nil if transaction succeeded
+}
+
+// Notification sent by slotSubscribe every time a slot is processed by the validator.
+type SlotNotification struct {
+	Parent uint `json:"parent"` //The parent slot
+	Root   uint `json:"root"`   //The current root slot
+	Slot   uint `json:"slot"`   //The newly set slot value
+}
+
+// Notification sent by blockSubscribe when a block matching the filter is confirmed or finalized.
+type BlockNotification struct {
+	Slot  uint          //The slot of the block
+	Err   any           //Error if something went wrong publishing the notification, otherwise nil
+	Block *solana.Block //The block, nil if Err is set
+}
+
+// Selects the transactions logsSubscribe notifies about.
+type LogsFilter struct {
+	value any
+}
+
+var (
+	LogsFilterAll          = LogsFilter{"all"}          //Every transaction except simple vote transactions
+	LogsFilterAllWithVotes = LogsFilter{"allWithVotes"} //Every transaction including simple vote transactions
+)
+
+// Selects the transactions mentioning the given address.
+func LogsFilterMentions(address solana.Pubkey) LogsFilter {
+	return LogsFilter{map[string][]string{"mentions": {address.String()}}}
+}
+
+func (f LogsFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.value)
+}
+
+// Selects the blocks blockSubscribe notifies about.
+type BlockFilter struct {
+	value any
+}
+
+var BlockFilterAll = BlockFilter{"all"} //Every block
+
+// Selects the blocks with a transaction mentioning the given account or program.
+func BlockFilterMentions(address solana.Pubkey) BlockFilter {
+	return BlockFilter{map[string]string{"mentionsAccountOrProgram": address.String()}}
+}
+
+func (f BlockFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.value)
+}
+
+type SignatureSubscribeConfig struct {
+	Commitment                 *solana.Commitment `json:"commitment,omitempty"`                 //For preflight checks and transaction processing, Solana nodes choose which bank state to query based on a commitment requirement set by the client.
+	EnableReceivedNotification *bool              `json:"enableReceivedNotification,omitempty"` //Whether to also notify when the signature is received by the node
+}
+
+// An open subscription. Notifications are delivered on C, which is closed when the subscription ends.
+type Subscription[T any] struct {
+	C <-chan T
+
+	sub    *subscription
+	client *WsClient
+}
+
+// Ends the subscription and closes C.
+func (s *Subscription[T]) Unsubscribe(ctx context.Context) error {
+	return s.client.unsubscribe(ctx, s.sub)
+}
+
+// Returns why C was closed: nil after Unsubscribe or once a signature notification was delivered, the error otherwise. Returns nil while the subscription is open.
+func (s *Subscription[T]) Err() error {
+	select {
+	case <-s.sub.done:
+		return s.sub.err
+	default:
+		return nil
+	}
+}
+
+// Subscribes to changes of the lamports or data of an account. Account data is always requested as base64.
+func (c *WsClient) AccountSubscribe(ctx context.Context, address solana.Pubkey, config ...solana.GetAccountInfoConfig) (*Subscription[AccountNotification], error) {
+	return subscribe(ctx, c, "accountSubscribe", "accountUnsubscribe", accountInfoParams(address, config), false, func(result json.RawMessage) (AccountNotification, error) {
+		var res struct {
+			Context struct {
+				Slot uint `json:"slot"`
+			} `json:"context"`
+			Value encodedAccount `json:"value"`
+		}
+		if err := json.Unmarshal(result, &res); err != nil {
+			return AccountNotification{}, err
+		}
+		return AccountNotification{Slot: res.Context.Slot, Account: res.Value.account(address)}, nil
+	})
+}
+
+// Subscribes to changes of the accounts owned by a program. Account data is always requested as base64.
+func (c *WsClient) ProgramSubscribe(ctx context.Context, programPubkey solana.Pubkey, config ...solana.GetAccountInfoConfig) (*Subscription[ProgramNotification], error) {
+	return subscribe(ctx, c, "programSubscribe", "programUnsubscribe", accountInfoParams(programPubkey, config), false, func(result json.RawMessage) (ProgramNotification, error) {
+		var res struct {
+			Context struct {
+				Slot uint `json:"slot"`
+			} `json:"context"`
+			Value struct {
+				Pubkey  solana.PubkeyStr `json:"pubkey"`
+				Account encodedAccount   `json:"account"`
+			} `json:"value"`
+		}
+		if err := json.Unmarshal(result, &res); err != nil {
+			return ProgramNotification{}, err
+		}
+		return ProgramNotification{Slot: res.Context.Slot, Account: *res.Value.Account.account(&res.Value.Pubkey)}, nil
+	})
+}
+
+// Subscribes to the logs of the transactions matching the filter.
+func (c *WsClient) LogsSubscribe(ctx context.Context, filter LogsFilter, config ...solana.StandardCommitmentConfig) (*Subscription[LogsNotification], error) {
+	params := []interface{}{filter}
+	if len(config) > 0 {
+		params = append(params, config[0])
+	}
+	return subscribe(ctx, c, "logsSubscribe", "logsUnsubscribe", params, false, func(result json.RawMessage) (LogsNotification, error) {
+		var res struct {
+			Context struct {
+				Slot uint `json:"slot"`
+			} `json:"context"`
+			Value struct {
+				Signature string                   `json:"signature"`
+				Err       *solana.TransactionError `json:"err"`
+				Logs      []string                 `json:"logs"`
+			} `json:"value"`
+		}
+		if err := json.Unmarshal(result, &res); err != nil {
+			return LogsNotification{}, err
+		}
+		return LogsNotification{Slot: res.Context.Slot, Signature: res.Value.Signature, Err: res.Value.Err, Logs: res.Value.Logs}, nil
+	})
+}
+
+// Subscribes to the status of a transaction. The node ends the subscription once the transaction reached the requested commitment, after which C is closed.
+func (c *WsClient) SignatureSubscribe(ctx context.Context, signature string, config ...SignatureSubscribeConfig) (*Subscription[SignatureNotification], error) {
+	params := []interface{}{signature}
+	if len(config) > 0 {
+		params = append(params, config[0])
+	}
+	return subscribe(ctx, c, "signatureSubscribe", "signatureUnsubscribe", params, true, func(result json.RawMessage) (SignatureNotification, error) {
+		var res struct {
+			Context struct {
+				Slot uint `json:"slot"`
+			} `json:"context"`
+			Value json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(result, &res); err != nil {
+			return SignatureNotification{}, err
+		}
+		var received string
+		if err := json.Unmarshal(res.Value, &received); err == nil {
+			return SignatureNotification{Slot: res.Context.Slot, Received: received == "receivedSignature"}, nil
+		}
+		var value struct {
+			Err *solana.TransactionError `json:"err"`
+		}
+		if err := json.Unmarshal(res.Value, &value); err != nil {
+			return SignatureNotification{}, err
+		}
+		return SignatureNotification{Slot: res.Context.Slot, Err: value.Err}, nil
+	})
+}
+
+var _ solana.SignatureSubscriber = (*WsClient)(nil)
+
+// Waits for the transaction with the signature to reach the commitment through a signature subscription. Pass the client to solana.WithSignatureSubscriber to have confirmations seen as soon as they happen.
+func (c *WsClient) WaitForSignature(ctx context.Context, signature string, commitment solana.Commitment) (uint, *solana.TransactionError, error) {
+	sub, err := c.SignatureSubscribe(ctx, signature, SignatureSubscribeConfig{Commitment: &commitment})
+	if err != nil {
+		return 0, nil, err
+	}
+	select {
+	case notification, ok := <-sub.C:
+		if !ok {
+			if err := sub.Err(); err != nil {
+				return 0, nil, err
+			}
+			return 0, nil, errors.New("signature subscription ended without a notification")
+		}
+		return notification.Slot, notification.Err, nil
+	case <-ctx.Done():
+		sub.Unsubscribe(context.Background())
+		return 0, nil, ctx.Err()
+	}
+}
+
+// Subscribes to every slot processed by the validator.
+func (c *WsClient) SlotSubscribe(ctx context.Context) (*Subscription[SlotNotification], error) {
+	return subscribe(ctx, c, "slotSubscribe", "slotUnsubscribe", nil, false, func(result json.RawMessage) (SlotNotification, error) {
+		var res SlotNotification
+		err := json.Unmarshal(result, &res)
+		return res, err
+	})
+}
+
+// Subscribes to every new root set by the validator.
+func (c *WsClient) RootSubscribe(ctx context.Context) (*Subscription[uint], error) {
+	return subscribe(ctx, c, "rootSubscribe", "rootUnsubscribe", nil, false, func(result json.RawMessage) (uint, error) {
+		var res uint
+		err := json.Unmarshal(result, &res)
+		return res, err
+	})
+}
+
+// Subscribes to the blocks matching the filter. Transactions are always requested as base64 and decoded the same way GetBlock does. Not every node enables this subscription.
+func (c *WsClient) BlockSubscribe(ctx context.Context, filter BlockFilter, config ...solana.GetBlockConfig) (*Subscription[BlockNotification], error) {
+	params := []interface{}{filter, blockConfig(config)}
+	return subscribe(ctx, c, "blockSubscribe", "blockUnsubscribe", params, false, func(result json.RawMessage) (BlockNotification, error) {
+		var res struct {
+			Value struct {
+				Slot  uint   `json:"slot"`
+				Err   any    `json:"err"`
+				Block *block `json:"block"`
+			} `json:"value"`
+		}
+		if err := json.Unmarshal(result, &res); err != nil {
+			return BlockNotification{}, err
+		}
+		notification := BlockNotification{Slot: res.Value.Slot, Err: res.Value.Err}
+		if res.Value.Block != nil {
+			block, err := res.Value.Block.block()
+			if err != nil {
+				return BlockNotification{}, err
+			}
+			notification.Block = block
+		}
+		return notification, nil
+	})
+}
+
+type subscription struct {
+	method            string
+	unsubscribeMethod string
+	params            any
+	once              bool                        //The node ends the subscription after the first notification
+	deliver           func(json.RawMessage) error //Decodes a notification and sends it on the channel
+	closeChannel      func()
+
+	activateMu sync.Mutex
+	id         int  //Id assigned by the node, guarded by the client's mutex
+	generation int  //Connection the subscription is active on, zero if none. Guarded by the client's mutex.
+	completed  bool //Set when the node ended a once subscription, guarded by the client's mutex
+
+	deliverMu sync.Mutex //Prevents the channel from being closed during a delivery
+	done      chan struct{}
+	endOnce   sync.Once
+	err       error
+}
+
+func subscribe[T any](ctx context.Context, c *WsClient, method, unsubscribeMethod string, params any, once bool, decode func(json.RawMessage) (T, error)) (*Subscription[T], error) {
+	ch := make(chan T, c.subscriptionBuffer)
+	sub := &subscription{
+		method:            method,
+		unsubscribeMethod: unsubscribeMethod,
+		params:            params,
+		once:              once,
+		done:              make(chan struct{}),
+		closeChannel:      func() { close(ch) },
+	}
+	sub.deliver = func(result json.RawMessage) error {
+		notification, err := decode(result)
+		if err != nil {
+			return err
+		}
+		select {
+		case ch <- notification:
+		case <-sub.done:
+		}
+		return nil
+	}
+
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		return nil, ErrWsClientClosed
+	}
+	c.subscriptions[sub] = struct{}{}
+	c.mu.Unlock()
+
+	if err := c.activate(ctx, sub); err != nil {
+		c.unsubscribe(context.Background(), sub)
+		return nil, err
+	}
+	return &Subscription[T]{C: ch, sub: sub, client: c}, nil
+}
+
+// Subscribes on the current connection unless the subscription is already active on it.
+func (c *WsClient) activate(ctx context.Context, sub *subscription) error {
+	sub.activateMu.Lock()
+	defer sub.activateMu.Unlock()
+
+	c.mu.Lock()
+	_, open := c.subscriptions[sub]
+	isActive := c.conn != nil && sub.generation == c.generation
+	c.mu.Unlock()
+	if !open || isActive {
+		return nil
+	}
+
+	var id int
+	if err := c.call(ctx, sub.method, sub.params, &id, sub); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	_, open = c.subscriptions[sub]
+	completed := sub.completed
+	c.mu.Unlock()
+	if !open && !completed {
+		//Unsubscribed while the request was in flight
+		c.call(context.Background(), sub.unsubscribeMethod, []interface{}{id}, new(bool), nil)
+	}
+	return nil
+}
+
+// Called by the read loop when the node confirms a subscription, before any later message is read so no notification is missed.
+func (c *WsClient) register(sub *subscription, result json.RawMessage) {
+	var id int
+	if err := json.Unmarshal(result, &id); err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, open := c.subscriptions[sub]; open {
+		sub.id = id
+		sub.generation = c.generation
+		c.active[id] = sub
+	}
+}
+
+func (c *WsClient) unsubscribe(ctx context.Context, sub *subscription) error {
+	c.mu.Lock()
+	_, open := c.subscriptions[sub]
+	delete(c.subscriptions, sub)
+	active := sub.generation != 0 && sub.generation == c.generation && c.active[sub.id] == sub
+	if active {
+		delete(c.active, sub.id)
+	}
+	id := sub.id
+	c.mu.Unlock()
+
+	sub.end(nil)
+	if !open || !active {
+		return nil
+	}
+	var res bool
+	return c.call(ctx, sub.unsubscribeMethod, []interface{}{id}, &res, nil)
+}
+
+func (s *subscription) end(err error) {
+	s.endOnce.Do(func() {
+		s.err = err
+		close(s.done)
+		s.deliverMu.Lock()
+		s.closeChannel()
+		s.deliverMu.Unlock()
+	})
+}
+
+type wsRequest struct {
+	response chan wsResponse
+	sub      *subscription //Set for subscribe requests
+}
+
+type wsResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// Sends a request on the current connection, waiting for a connection if the client is reconnecting. When sub is set, the subscription is registered under the id the node responds with.
+func (c *WsClient) call(ctx context.Context, method string, params any, res interface{}, sub *subscription) error {
+	conn, generation, err := c.connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if c.generation != generation {
+		c.mu.Unlock()
+		return c.call(ctx, method, params, res, sub)
+	}
+	id := c.nextID
+	c.nextID++
+	ch := make(chan wsResponse, 1)
+	c.requests[id] = &wsRequest{response: ch, sub: sub}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.requests, id)
+		c.mu.Unlock()
+	}()
+
+	data, err := json.Marshal(rpcReq{ID: id, Jsonrpc: "2.0", Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	if err := conn.writeMessage(data); err != nil {
+		return err
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.err != nil {
+			return resp.err
+		}
+		return decodeResult(resp.result, res)
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.ctx.Done():
+		return ErrWsClientClosed
+	}
+}
+
+func (c *WsClient) connection(ctx context.Context) (*wsConn, int, error) {
+	for {
+		c.mu.Lock()
+		conn, generation, ready := c.conn, c.generation, c.ready
+		c.mu.Unlock()
+		if c.ctx.Err() != nil {
+			return nil, 0, ErrWsClientClosed
+		}
+		if conn != nil {
+			return conn, generation, nil
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		case <-c.ctx.Done():
+			return nil, 0, ErrWsClientClosed
+		}
+	}
+}
+
+func (c *WsClient) dial(ctx context.Context) (*wsConn, error) {
+	conn, err := c.dialer.dial(ctx, c.Endpoint, c.header)
+	if err != nil {
+		return nil, err
+	}
+	if c.pingInterval > 0 {
+		conn.readTimeout = 2 * c.pingInterval
+	}
+	return conn, nil
+}
+
+// Makes conn the current connection. Reports false if the client was closed in the meantime.
+func (c *WsClient) connected(conn *wsConn) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ctx.Err() != nil {
+		return false
+	}
+	c.conn = conn
+	c.generation++
+	close(c.ready)
+	return true
+}
+
+// Reads from the connection until it fails, then reconnects and resubscribes until the client is closed.
+func (c *WsClient) run(conn *wsConn) {
+	for {
+		err := c.read(conn)
+		conn.conn.Close()
+		if c.disconnected(err) {
+			return
+		}
+
+		conn = c.reconnect()
+		if conn == nil {
+			return
+		}
+		go c.resubscribe()
+	}
+}
+
+// Fails the requests in flight and deactivates every subscription. Reports whether the client is closed.
+func (c *WsClient) disconnected(err error) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id, req := range c.requests {
+		req.response <- wsResponse{err: err}
+		delete(c.requests, id)
+	}
+	c.active = map[int]*subscription{}
+	if c.ctx.Err() != nil {
+		return true
+	}
+	c.conn = nil
+	c.ready = make(chan struct{})
+	return false
+}
+
+func (c *WsClient) reconnect() *wsConn {
+	delay := c.reconnectBackoff
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		conn, err := c.dial(c.ctx)
+		if err == nil {
+			if !c.connected(conn) {
+				conn.conn.Close()
+				return nil
+			}
+			return conn
+		}
+		delay = min(2*delay, max(c.maxReconnectDelay, c.reconnectBackoff))
+	}
+}
+
+// Restores the open subscriptions on a new connection. Subscriptions the node rejects are ended with its error.
+func (c *WsClient) resubscribe() {
+	c.mu.Lock()
+	subscriptions := make([]*subscription, 0, len(c.subscriptions))
+	for sub := range c.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+	c.mu.Unlock()
+
+	for _, sub := range subscriptions {
+		err := c.activate(c.ctx, sub)
+		var rpcErr *rpcError
+		if errors.As(err, &rpcErr) {
+			c.mu.Lock()
+			delete(c.subscriptions, sub)
+			c.mu.Unlock()
+			sub.end(err)
+		}
+	}
+}
+
+type wsMessage struct {
+	ID     *int            `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *jsonRpcError   `json:"error"`
+	Method string          `json:"method"`
+	Params struct {
+		Result       json.RawMessage `json:"result"`
+		Subscription int             `json:"subscription"`
+	} `json:"params"`
+}
+
+func (c *WsClient) read(conn *wsConn) error {
+	for {
+		data, err := conn.readMessage()
+		if err != nil {
+			return err
+		}
+		var msg wsMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+
+		if msg.ID != nil {
+			c.mu.Lock()
+			req, ok := c.requests[*msg.ID]
+			delete(c.requests, *msg.ID)
+			c.mu.Unlock()
+			if !ok {
+				continue
+			}
+			if msg.Error != nil {
+				req.response <- wsResponse{err: newRpcError(msg.Error, 0)}
+				continue
+			}
+			if req.sub != nil {
+				c.register(req.sub, msg.Result)
+			}
+			req.response <- wsResponse{result: msg.Result}
+			continue
+		}
+
+		if msg.Method != "" {
+			c.notify(msg.Params.Subscription, msg.Params.Result)
+		}
+	}
+}
+
+func (c *WsClient) notify(id int, result json.RawMessage) {
+	c.mu.Lock()
+	sub, ok := c.active[id]
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	sub.deliverMu.Lock()
+	select {
+	case <-sub.done:
+		sub.deliverMu.Unlock()
+		return
+	default:
+	}
+	err := sub.deliver(result)
+	sub.deliverMu.Unlock()
+
+	if err != nil {
+		//A notification that cannot be decoded ends the subscription, so the error is not silently dropped.
+		//The unsubscribe response is read by this goroutine, so it must not wait for it.
+		sub.end(err)
+		go c.unsubscribe(c.ctx, sub)
+		return
+	}
+	if sub.once && !isReceivedNotification(result) {
+		c.mu.Lock()
+		sub.completed = true
+		delete(c.subscriptions, sub)
+		delete(c.active, id)
+		c.mu.Unlock()
+		sub.end(nil)
+	}
+}
+
+// The node keeps a signature subscription open after the optional "receivedSignature" notification.
+func isReceivedNotification(result json.RawMessage) bool {
+	var res struct {
+		Value any `json:"value"`
+	}
+	return json.Unmarshal(result, &res) == nil && res.Value == "receivedSignature"
+}
+
+func (c *WsClient) keepAlive() {
+	ticker := time.NewTicker(c.pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		c.mu.Lock()
+		conn := c.conn
+		c.mu.Unlock()
+		if conn != nil && conn.ping() != nil {
+			conn.conn.Close()
+		}
+	}
+}
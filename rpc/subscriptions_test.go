@@ -0,0 +1,373 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Stands in for the PubSub endpoint of a node. Every subscribe request gets a new subscription id and every request is reported on requests.
+type wsTestServer struct {
+	*httptest.Server
+	requests chan rpcReq
+
+	mu      sync.Mutex
+	conns   []*wsConn
+	nextSub int
+}
+
+func newWsTestServer(t *testing.T) *wsTestServer {
+	s := &wsTestServer{requests: make(chan rpcReq, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgradeWebsocket(w, r)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		s.mu.Lock()
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+
+		for {
+			data, err := conn.readMessage()
+			if err != nil {
+				return
+			}
+			var req rpcReq
+			if err := json.Unmarshal(data, &req); err != nil {
+				t.Error(err)
+				return
+			}
+			var result any = true
+			if strings.HasSuffix(req.Method, "Subscribe") {
+				s.mu.Lock()
+				s.nextSub++
+				result = s.nextSub
+				s.mu.Unlock()
+			}
+			res, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+			conn.writeMessage(res)
+			s.requests <- req
+		}
+	}))
+	return s
+}
+
+// Upgrades an HTTP request to a server side WebSocket connection.
+func upgradeWebsocket(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	conn, rw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", wsAcceptKey(r.Header.Get("Sec-WebSocket-Key")))
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, reader: rw.Reader}, nil
+}
+
+func (s *wsTestServer) endpoint() solana.RpcEndpoint {
+	return solana.RpcEndpoint("ws" + strings.TrimPrefix(s.URL, "http"))
+}
+
+func (s *wsTestServer) notify(t *testing.T, method string, subscription int, result string) {
+	s.mu.Lock()
+	conn := s.conns[len(s.conns)-1]
+	s.mu.Unlock()
+	message := fmt.Sprintf(`{"jsonrpc":"2.0","method":"%s","params":{"result":%s,"subscription":%d}}`, method, result, subscription)
+	if err := conn.writeMessage([]byte(message)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (s *wsTestServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		conn.conn.Close()
+	}
+}
+
+func (s *wsTestServer) expectRequest(t *testing.T, method string) rpcReq {
+	select {
+	case req := <-s.requests:
+		if req.Method != method {
+			t.Fatal("Unexpected request", req.Method, "expected", method)
+		}
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for", method)
+		return rpcReq{}
+	}
+}
+
+func receive[T any](t *testing.T, sub *Subscription[T]) T {
+	select {
+	case notification, ok := <-sub.C:
+		if !ok {
+			t.Fatal("Subscription closed", sub.Err())
+		}
+		return notification
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for notification")
+		var zero T
+		return zero
+	}
+}
+
+func expectClosed[T any](t *testing.T, sub *Subscription[T]) {
+	select {
+	case _, ok := <-sub.C:
+		if ok {
+			t.Fatal("Expected subscription to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for subscription to close")
+	}
+}
+
+func TestWsEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"http://localhost:8899":              "ws://localhost:8900",
+		"https://api.devnet.solana.com":      "wss://api.devnet.solana.com",
+		"wss://api.mainnet-beta.solana.com/": "wss://api.mainnet-beta.solana.com/",
+	}
+	for endpoint, expected := range tests {
+		if actual := wsEndpoint(endpoint); actual != expected {
+			t.Fatal("Unexpected endpoint", actual, expected)
+		}
+	}
+}
+
+func TestAccountSubscribe(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	client, err := NewWsClient(context.Background(), server.endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	address := solana.MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY")
+	sub, err := client.AccountSubscribe(context.Background(), address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := server.expectRequest(t, "accountSubscribe")
+	if params := req.Params.([]any); params[0] != address.String() || params[1].(map[string]any)["encoding"] != "base64" {
+		t.Fatal("Unexpected params", req.Params)
+	}
+
+	server.notify(t, "accountNotification", 1, `{"context":{"slot":5},"value":{"data":["AQID","base64"],"executable":false,"lamports":100,"owner":"11111111111111111111111111111111","rentEpoch":0,"space":3}}`)
+	notification := receive(t, sub)
+	if notification.Slot != 5 || notification.Account.Lamports != 100 || string(notification.Account.Data) != "\x01\x02\x03" {
+		t.Fatal("Unexpected notification", notification)
+	}
+	if notification.Account.Address.String() != address.String() {
+		t.Fatal("Unexpected address", notification.Account.Address)
+	}
+
+	if err := sub.Unsubscribe(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	req = server.expectRequest(t, "accountUnsubscribe")
+	if params := req.Params.([]any); params[0] != float64(1) {
+		t.Fatal("Unexpected params", req.Params)
+	}
+	expectClosed(t, sub)
+	if sub.Err() != nil {
+		t.Fatal("Expected no error after unsubscribing", sub.Err())
+	}
+}
+
+func TestSignatureSubscribe(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	client, err := NewWsClient(context.Background(), server.endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	enable := true
+	sub, err := client.SignatureSubscribe(context.Background(), "sig", SignatureSubscribeConfig{EnableReceivedNotification: &enable})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.expectRequest(t, "signatureSubscribe")
+
+	server.notify(t, "signatureNotification", 1, `{"context":{"slot":7},"value":"receivedSignature"}`)
+	if notification := receive(t, sub); !notification.Received || notification.Err != nil {
+		t.Fatal("Unexpected notification", notification)
+	}
+	server.notify(t, "signatureNotification", 1, `{"context":{"slot":8},"value":{"err":{"InstructionError":[0,{"Custom":1}]}}}`)
+	notification := receive(t, sub)
+	if notification.Received || notification.Slot != 8 || !errors.Is(notification.Err, solana.CustomInstructionError(1)) {
+		t.Fatal("Unexpected notification", notification)
+	}
+	expectClosed(t, sub)
+	if sub.Err() != nil {
+		t.Fatal("Expected no error", sub.Err())
+	}
+}
+
+func TestWaitForSignature(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	client, err := NewWsClient(context.Background(), server.endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	type result struct {
+		slot  uint
+		txErr *solana.TransactionError
+		err   error
+	}
+	results := make(chan result)
+	go func() {
+		slot, txErr, err := client.WaitForSignature(context.Background(), "sig", solana.CommitmentConfirmed)
+		results <- result{slot, txErr, err}
+	}()
+	req := server.expectRequest(t, "signatureSubscribe")
+	if config, _ := req.Params.([]any)[1].(map[string]any); config["commitment"] != "confirmed" {
+		t.Fatal("Unexpected params", req.Params)
+	}
+	server.notify(t, "signatureNotification", 1, `{"context":{"slot":8},"value":{"err":null}}`)
+	res := <-results
+	if res.err != nil || res.txErr != nil || res.slot != 8 {
+		t.Fatal("Unexpected result", res)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		slot, txErr, err := client.WaitForSignature(ctx, "sig", solana.CommitmentConfirmed)
+		results <- result{slot, txErr, err}
+	}()
+	server.expectRequest(t, "signatureSubscribe")
+	cancel()
+	if res := <-results; !errors.Is(res.err, context.Canceled) {
+		t.Fatal("Expected the wait to be cancelled, got", res.err)
+	}
+}
+
+func TestSubscriptionNotifications(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	client, err := NewWsClient(context.Background(), server.endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	program := solana.MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+
+	slots, err := client.SlotSubscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.notify(t, "slotNotification", 1, `{"parent":74,"root":42,"slot":75}`)
+	if notification := receive(t, slots); notification != (SlotNotification{Parent: 74, Root: 42, Slot: 75}) {
+		t.Fatal("Unexpected slot notification", notification)
+	}
+
+	roots, err := client.RootSubscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.notify(t, "rootNotification", 2, `42`)
+	if root := receive(t, roots); root != 42 {
+		t.Fatal("Unexpected root", root)
+	}
+
+	logs, err := client.LogsSubscribe(ctx, LogsFilterMentions(program))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.notify(t, "logsNotification", 3, `{"context":{"slot":5},"value":{"signature":"sig","err":null,"logs":["Program log: hello"]}}`)
+	if notification := receive(t, logs); notification.Signature != "sig" || notification.Err != nil || len(notification.Logs) != 1 {
+		t.Fatal("Unexpected logs notification", notification)
+	}
+
+	accounts, err := client.ProgramSubscribe(ctx, program)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.notify(t, "programNotification", 4, `{"context":{"slot":5},"value":{"pubkey":"5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY","account":{"data":["AQ==","base64"],"executable":false,"lamports":1,"owner":"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA","rentEpoch":0,"space":1}}}`)
+	if notification := receive(t, accounts); notification.Account.Address.String() != "5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrY" || len(notification.Account.Data) != 1 {
+		t.Fatal("Unexpected program notification", notification)
+	}
+
+	blocks, err := client.BlockSubscribe(ctx, BlockFilterAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.notify(t, "blockNotification", 5, `{"context":{"slot":9},"value":{"slot":9,"err":null,"block":{"blockhash":"hash","parentSlot":8,"previousBlockhash":"previous","transactions":[]}}}`)
+	if notification := receive(t, blocks); notification.Slot != 9 || notification.Block == nil || notification.Block.ParentSlot != 8 {
+		t.Fatal("Unexpected block notification", notification)
+	}
+}
+
+func TestWsReconnect(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	client, err := NewWsClient(context.Background(), server.endpoint(), WithReconnectBackoff(time.Millisecond, 10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	sub, err := client.SlotSubscribe(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.expectRequest(t, "slotSubscribe")
+
+	server.dropConnections()
+	server.expectRequest(t, "slotSubscribe")
+	server.notify(t, "slotNotification", 2, `{"parent":1,"root":0,"slot":2}`)
+	if notification := receive(t, sub); notification.Slot != 2 {
+		t.Fatal("Unexpected notification", notification)
+	}
+
+	//Calls made after the reconnect use the new connection
+	if err := sub.Unsubscribe(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if req := server.expectRequest(t, "slotUnsubscribe"); req.Params.([]any)[0] != float64(2) {
+		t.Fatal("Expected the new subscription id", req.Params)
+	}
+}
+
+func TestWsClose(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	client, err := NewWsClient(context.Background(), server.endpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sub, err := client.RootSubscribe(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Close()
+	expectClosed(t, sub)
+	if !errors.Is(sub.Err(), ErrWsClientClosed) {
+		t.Fatal("Unexpected error", sub.Err())
+	}
+	if _, err := client.SlotSubscribe(context.Background()); !errors.Is(err, ErrWsClientClosed) {
+		t.Fatal("Unexpected error", err)
+	}
+}
@@ -1,10 +1,11 @@
 package rpc
 
 import (
+	"context"
 	"github.com/hwsimmons17/solana-web3.go"
 )
 
-func (r *RpcClient) GetTokenAccountBalance(address solana.Pubkey, config ...solana.StandardCommitmentConfig) (solana.UiTokenAmount, error) {
+func (r *RpcClient) GetTokenAccountBalance(ctx context.Context, address solana.Pubkey, config ...solana.StandardCommitmentConfig) (solana.UiTokenAmount, error) {
 	var res struct {
 		Value solana.UiTokenAmount `json:"value"`
 	}
@@ -12,13 +13,13 @@ func (r *RpcClient) GetTokenAccountBalance(address solana.Pubkey, config ...sola
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getTokenAccountBalance", params, &res); err != nil {
+	if err := r.send(ctx, "getTokenAccountBalance", params, &res); err != nil {
 		return solana.UiTokenAmount{}, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetTokenAccountsByDelegate(delegateAddress solana.Pubkey, opts solana.GetTokenAccountsByDelegateConfig, config ...solana.GetAccountInfoConfig) ([]solana.Account, error) {
+func (r *RpcClient) GetTokenAccountsByDelegate(ctx context.Context, delegateAddress solana.Pubkey, opts solana.GetTokenAccountsByDelegateConfig, config ...solana.GetAccountInfoConfig) ([]solana.Account, error) {
 	var res struct {
 		Value []struct {
 			Account encodedAccount `json:"account"`
@@ -33,7 +34,7 @@ func (r *RpcClient) GetTokenAccountsByDelegate(delegateAddress solana.Pubkey, op
 	} else {
 		params = append(params, solana.GetAccountInfoConfig{Encoding: &encoding})
 	}
-	if err := r.send("getTokenAccountsByDelegate", params, &res); err != nil {
+	if err := r.send(ctx, "getTokenAccountsByDelegate", params, &res); err != nil {
 		return nil, err
 	}
 
@@ -57,7 +58,7 @@ func (r *RpcClient) GetTokenAccountsByDelegate(delegateAddress solana.Pubkey, op
 	return accounts, nil
 }
 
-func (r *RpcClient) GetTokenAccountsByOwner(ownerAddress solana.Pubkey, opts solana.GetTokenAccountsByDelegateConfig, config ...solana.GetAccountInfoConfig) ([]solana.Account, error) {
+func (r *RpcClient) GetTokenAccountsByOwner(ctx context.Context, ownerAddress solana.Pubkey, opts solana.GetTokenAccountsByDelegateConfig, config ...solana.GetAccountInfoConfig) ([]solana.Account, error) {
 	var res struct {
 		Value []struct {
 			Account encodedAccount `json:"account"`
@@ -72,7 +73,7 @@ func (r *RpcClient) GetTokenAccountsByOwner(ownerAddress solana.Pubkey, opts sol
 	} else {
 		params = append(params, solana.GetAccountInfoConfig{Encoding: &encoding})
 	}
-	if err := r.send("getTokenAccountsByOwner", params, &res); err != nil {
+	if err := r.send(ctx, "getTokenAccountsByOwner", params, &res); err != nil {
 		return nil, err
 	}
 
@@ -96,7 +97,7 @@ func (r *RpcClient) GetTokenAccountsByOwner(ownerAddress solana.Pubkey, opts sol
 	return accounts, nil
 }
 
-func (r *RpcClient) GetTokenLargestAccounts(mintAddress solana.Pubkey, config ...solana.StandardCommitmentConfig) ([]solana.UiTokenAmount, error) {
+func (r *RpcClient) GetTokenLargestAccounts(ctx context.Context, mintAddress solana.Pubkey, config ...solana.StandardCommitmentConfig) ([]solana.UiTokenAmount, error) {
 	var res struct {
 		Value []solana.UiTokenAmount `json:"value"`
 	}
@@ -104,14 +105,14 @@ func (r *RpcClient) GetTokenLargestAccounts(mintAddress solana.Pubkey, config ..
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getTokenLargestAccounts", params, &res); err != nil {
+	if err := r.send(ctx, "getTokenLargestAccounts", params, &res); err != nil {
 		return nil, err
 	}
 
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetTokenSupply(mintAddress solana.Pubkey, config ...solana.StandardCommitmentConfig) (solana.UiTokenAmount, error) {
+func (r *RpcClient) GetTokenSupply(ctx context.Context, mintAddress solana.Pubkey, config ...solana.StandardCommitmentConfig) (solana.UiTokenAmount, error) {
 	var res struct {
 		Value solana.UiTokenAmount `json:"value"`
 	}
@@ -119,13 +120,13 @@ func (r *RpcClient) GetTokenSupply(mintAddress solana.Pubkey, config ...solana.S
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getTokenSupply", params, &res); err != nil {
+	if err := r.send(ctx, "getTokenSupply", params, &res); err != nil {
 		return solana.UiTokenAmount{}, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetAsset(pubkey solana.Pubkey, config ...solana.GetAssetConfig) (solana.GetAssetResult, error) {
+func (r *RpcClient) GetAsset(ctx context.Context, pubkey solana.Pubkey, config ...solana.GetAssetConfig) (solana.GetAssetResult, error) {
 	var res solana.GetAssetResult
 
 	type getAssetParams struct {
@@ -138,7 +139,7 @@ func (r *RpcClient) GetAsset(pubkey solana.Pubkey, config ...solana.GetAssetConf
 	if len(config) > 0 {
 		params.DisplayOptions = &config[0]
 	}
-	if err := r.send("getAsset", params, &res); err != nil {
+	if err := r.send(ctx, "getAsset", params, &res); err != nil {
 		return res, err
 	}
 	return res, nil
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -9,12 +10,40 @@ import (
 )
 
 type encodedTransaction struct {
-	Meta        *solana.TransactionMeta `json:"meta"`
-	Version     *int                    `json:"version"` //Transaction version. Undefined if maxSupportedTransactionVersion is not set in request params. --note can also be "legacy"
-	Transaction []string                `json:"transaction"`
+	Meta        *solana.TransactionMeta    `json:"meta"`
+	Version     *solana.TransactionVersion `json:"version"` //Transaction version, "legacy" or 0. Undefined if maxSupportedTransactionVersion is not set in request params.
+	Transaction []string                   `json:"transaction"`
 }
 
-func (r *RpcClient) GetFeeForMessage(msg []byte, config ...solana.StandardRpcConfig) (*uint, error) {
+// Decodes the base64 encoded transaction, resolving the accounts a version 0 message loads from lookup tables with the loaded addresses in the meta.
+func (t encodedTransaction) transaction() (solana.TransactionWithMeta, error) {
+	if len(t.Transaction) == 0 {
+		return solana.TransactionWithMeta{}, errors.New("transaction not found")
+	}
+	transactionData, err := base64.StdEncoding.DecodeString(t.Transaction[0])
+	if err != nil {
+		return solana.TransactionWithMeta{}, fmt.Errorf("failed to decode transaction data: %v", err)
+	}
+	rawTx, err := solana.ParseTransactionData(transactionData)
+	if err != nil {
+		return solana.TransactionWithMeta{}, fmt.Errorf("failed to parse transaction data: %v", err)
+	}
+	var loadedAddresses []solana.LoadedAddresses
+	if t.Meta != nil {
+		loadedAddresses = append(loadedAddresses, t.Meta.LoadedAddresses)
+	}
+	transaction, err := rawTx.Transaction(loadedAddresses...)
+	if err != nil {
+		return solana.TransactionWithMeta{}, fmt.Errorf("failed to parse transaction: %v", err)
+	}
+	return solana.TransactionWithMeta{
+		Meta:        t.Meta,
+		Version:     t.Version,
+		Transaction: transaction,
+	}, nil
+}
+
+func (r *RpcClient) GetFeeForMessage(ctx context.Context, msg []byte, config ...solana.StandardRpcConfig) (*uint, error) {
 	var res struct {
 		Value *uint `json:"value"`
 	}
@@ -22,13 +51,13 @@ func (r *RpcClient) GetFeeForMessage(msg []byte, config ...solana.StandardRpcCon
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getFeeForMessage", params, &res); err != nil {
+	if err := r.send(ctx, "getFeeForMessage", params, &res); err != nil {
 		return nil, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetLatestBlockhash(config ...solana.StandardRpcConfig) (solana.LatestBlockhash, error) {
+func (r *RpcClient) GetLatestBlockhash(ctx context.Context, config ...solana.StandardRpcConfig) (solana.LatestBlockhash, error) {
 	var res struct {
 		Value solana.LatestBlockhash `json:"value"`
 	}
@@ -36,13 +65,13 @@ func (r *RpcClient) GetLatestBlockhash(config ...solana.StandardRpcConfig) (sola
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getLatestBlockhash", params, &res); err != nil {
+	if err := r.send(ctx, "getLatestBlockhash", params, &res); err != nil {
 		return solana.LatestBlockhash{}, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetSignatureStatuses(signatures []string, config ...solana.GetSignatureStatusesConfig) ([]*solana.SignatureStatus, error) {
+func (r *RpcClient) GetSignatureStatuses(ctx context.Context, signatures []string, config ...solana.GetSignatureStatusesConfig) ([]*solana.SignatureStatus, error) {
 	var res struct {
 		Value []*solana.SignatureStatus `json:"value"`
 	}
@@ -50,64 +79,53 @@ func (r *RpcClient) GetSignatureStatuses(signatures []string, config ...solana.G
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getSignatureStatuses", params, &res); err != nil {
+	if err := r.send(ctx, "getSignatureStatuses", params, &res); err != nil {
 		return nil, err
 	}
 	return res.Value, nil
 }
 
-func (r *RpcClient) GetSignaturesForAddress(address solana.Pubkey, config ...solana.GetSignaturesForAddressConfig) ([]solana.TransactionSignature, error) {
+func (r *RpcClient) GetSignaturesForAddress(ctx context.Context, address solana.Pubkey, config ...solana.GetSignaturesForAddressConfig) ([]solana.TransactionSignature, error) {
 	var res []solana.TransactionSignature
 	params := []interface{}{address.String()}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("getSignaturesForAddress", params, &res); err != nil {
+	if err := r.send(ctx, "getSignaturesForAddress", params, &res); err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *RpcClient) GetTransaction(transactionSignature string, config ...solana.GetTransactionSignatureConfig) (*solana.TransactionWithMeta, error) {
+func (r *RpcClient) GetTransaction(ctx context.Context, transactionSignature string, config ...solana.GetTransactionSignatureConfig) (*solana.TransactionWithMeta, error) {
 	var res *encodedTransaction
-	params := []interface{}{transactionSignature}
+	// Set the encoding to base64 no matter what and accept every transaction version that can be decoded
 	encoding := solana.EncodingBase64
+	version := maxSupportedTransactionVersion
+	params := []interface{}{transactionSignature}
 	if len(config) > 0 {
 		config[0].Encoding = &encoding
+		if config[0].MaxSupportedTransactionVersion == nil {
+			config[0].MaxSupportedTransactionVersion = &version
+		}
 		params = append(params, config[0])
 	} else {
-		params = append(params, solana.GetTransactionSignatureConfig{Encoding: &encoding})
+		params = append(params, solana.GetTransactionSignatureConfig{Encoding: &encoding, MaxSupportedTransactionVersion: &version})
 	}
-	if err := r.send("getTransaction", params, &res); err != nil {
+	if err := r.send(ctx, "getTransaction", params, &res); err != nil {
 		return nil, err
 	}
 	if res == nil {
 		return nil, nil
 	}
-	if len(res.Transaction) == 0 {
-		return nil, errors.New("transaction not found")
-	}
-	transactionData, err := base64.StdEncoding.DecodeString(res.Transaction[0])
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode transaction data: %v", err)
-	}
-	rawTx, err := solana.ParseTransactionData(transactionData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse transaction data: %v", err)
-	}
-	transaction, err := rawTx.Transaction()
+	transaction, err := res.transaction()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse transaction: %v", err)
+		return nil, err
 	}
-
-	return &solana.TransactionWithMeta{
-		Meta:        res.Meta,
-		Version:     res.Version,
-		Transaction: transaction,
-	}, nil
+	return &transaction, nil
 }
 
-func (r *RpcClient) IsBlockhashValid(blockhash string, config ...solana.StandardRpcConfig) (bool, error) {
+func (r *RpcClient) IsBlockhashValid(ctx context.Context, blockhash string, config ...solana.StandardRpcConfig) (bool, error) {
 	var res struct {
 		Value bool `json:"value"`
 	}
@@ -115,33 +133,33 @@ func (r *RpcClient) IsBlockhashValid(blockhash string, config ...solana.Standard
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("isBlockhashValid", params, &res); err != nil {
+	if err := r.send(ctx, "isBlockhashValid", params, &res); err != nil {
 		return false, err
 	}
 	return res.Value, nil
 }
 
 // NOTE: This function is not tested yet
-func (r *RpcClient) SendTransaction(fullySignedTransaction string, config ...solana.SendTransactionConfig) (string, error) {
+func (r *RpcClient) SendTransaction(ctx context.Context, fullySignedTransaction string, config ...solana.SendTransactionConfig) (string, error) {
 	var res string
 	params := []interface{}{fullySignedTransaction}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("sendTransaction", params, &res); err != nil {
+	if err := r.send(ctx, "sendTransaction", params, &res); err != nil {
 		return "", err
 	}
 	return res, nil
 }
 
 // NOTE: This function is not tested yet
-func (r *RpcClient) SimulateTransaction(transaction string, config ...solana.SimulateTransactionConfig) (solana.SimulateTransactionResult, error) {
+func (r *RpcClient) SimulateTransaction(ctx context.Context, transaction string, config ...solana.SimulateTransactionConfig) (solana.SimulateTransactionResult, error) {
 	var res solana.SimulateTransactionResult
 	params := []interface{}{transaction}
 	if len(config) > 0 {
 		params = append(params, config[0])
 	}
-	if err := r.send("simulateTransaction", params, &res); err != nil {
+	if err := r.send(ctx, "simulateTransaction", params, &res); err != nil {
 		return solana.SimulateTransactionResult{}, err
 	}
 	return res, nil
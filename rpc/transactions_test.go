@@ -1,6 +1,11 @@
 package rpc
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hwsimmons17/solana-web3.go"
@@ -78,3 +83,48 @@ func TestIsBlockhashValid(t *testing.T) {
 	}
 	t.Fatal(valid)
 }
+
+func TestGetVersionedTransaction(t *testing.T) {
+	payer := solana.MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	loaded := solana.MustParsePubkey("2u83Dx5qPV4QnujjJQv8v2SoqG1ixuAxPK5Jwhtkovd1")
+	table := solana.AddressLookupTable{Key: solana.MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY"), Addresses: []solana.Pubkey{loaded}}
+	tx := solana.Transaction{
+		Signatures: []string{"5WUMzKkDaSuLUj3RpbufHi2PLRPgkjkHhsJpLE7Q3a3fMNDkV579zDmWLfMTnw4my5cbHKicRhYDTQsoAidv8nYD"},
+		Message: solana.Message{
+			Instructions:    []solana.Instruction{solana.SystemProgramInstructions().Transfer(payer, loaded, 1)},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	rawTx, err := tx.Serialize(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rawTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var params []any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcReq
+		json.NewDecoder(r.Body).Decode(&req)
+		params = req.Params.([]any)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":{"meta":{"err":null,"fee":5000,"loadedAddresses":{"writable":["%s"],"readonly":[]}},"version":0,"transaction":["%s","base64"]}}`, req.ID, loaded, base64.StdEncoding.EncodeToString(data))
+	}))
+	defer server.Close()
+
+	res, err := NewRpcClient(solana.RpcEndpoint(server.URL)).GetTransaction("sig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config := params[1].(map[string]any); config["maxSupportedTransactionVersion"] != float64(0) || config["encoding"] != "base64" {
+		t.Fatal("Unexpected config", config)
+	}
+	if res.Version == nil || *res.Version != solana.TransactionVersion0 {
+		t.Fatal("Unexpected version", res.Version)
+	}
+	accounts := res.Transaction.Message.Instructions[0].Accounts
+	if accounts[1].Pubkey.String() != loaded.String() || !accounts[1].Writable {
+		t.Fatal("Expected the destination to be loaded from the lookup table", accounts)
+	}
+}
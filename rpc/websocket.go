@@ -0,0 +1,378 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Minimal RFC 6455 implementation, covering what the PubSub API needs: text messages, fragmentation, ping/pong and close.
+
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xa
+
+	wsGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	//Block notifications can be large, but a message larger than this is treated as a protocol error
+	wsMaxMessageSize = 64 << 20
+)
+
+// Returned when the server closes the WebSocket connection.
+var errWsClosed = errors.New("websocket connection closed")
+
+type wsConn struct {
+	conn        net.Conn
+	reader      *bufio.Reader
+	client      bool          //Clients mask the frames they send, servers don't
+	readTimeout time.Duration //Connection is considered dead when no frame arrives in time, zero for no timeout
+
+	writeMu sync.Mutex
+}
+
+// Dials WebSocket connections the way http.Transport dials HTTP ones: through a proxy if one is configured, and with a base TLS configuration.
+type wsDialer struct {
+	proxy     func(*http.Request) (*url.URL, error) //Returns the proxy to tunnel through for a request, nil for none. Only HTTP and HTTPS proxies are supported.
+	tlsConfig *tls.Config                           //TLS configuration of wss connections, nil for the defaults
+}
+
+func (d wsDialer) dial(ctx context.Context, endpoint string, header http.Header) (*wsConn, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := d.dialTCP(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "wss" {
+		config := d.tlsConfig.Clone()
+		if config == nil {
+			config = &tls.Config{}
+		}
+		if config.ServerName == "" {
+			config.ServerName = u.Hostname()
+		}
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	//Abort the handshake if the context is done before the server answers
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	ws, err := wsHandshake(conn, u, header)
+	if !stop() {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ws, nil
+}
+
+// Opens a TCP connection to the host of u, tunneled through the proxy of u if there is one.
+func (d wsDialer) dialTCP(ctx context.Context, u *url.URL) (net.Conn, error) {
+	host := wsHostPort(u.Host, u.Scheme == "wss")
+	var proxyURL *url.URL
+	if d.proxy != nil {
+		//Proxies are chosen for the HTTP URL the WebSocket one stands for, e.g. by HTTPS_PROXY for wss
+		target := *u
+		target.Scheme = "http"
+		if u.Scheme == "wss" {
+			target.Scheme = "https"
+		}
+		var err error
+		if proxyURL, err = d.proxy(&http.Request{Method: http.MethodGet, URL: &target, Host: u.Host}); err != nil {
+			return nil, err
+		}
+	}
+
+	var dialer net.Dialer
+	if proxyURL == nil {
+		return dialer.DialContext(ctx, "tcp", host)
+	}
+	if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported proxy scheme: %s", proxyURL.Scheme)
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", wsHostPort(proxyURL.Host, proxyURL.Scheme == "https"))
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	err = wsConnect(conn, proxyURL, host)
+	if !stop() {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Asks the proxy on the other end of conn to open a tunnel to host.
+func wsConnect(conn net.Conn, proxyURL *url.URL, host string) error {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: host},
+		Host:   host,
+		Header: http.Header{},
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		return err
+	}
+	//The proxy sends nothing past its response until the handshake is sent through the tunnel, so the reader buffers nothing of it
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return fmt.Errorf("proxy refused to connect to %s. Status code: %d", host, resp.StatusCode)
+	}
+	return nil
+}
+
+// Returns hostport with the default port of its scheme if it has none.
+func wsHostPort(hostport string, secure bool) string {
+	u := url.URL{Host: hostport}
+	if u.Port() != "" {
+		return hostport
+	}
+	if secure {
+		return net.JoinHostPort(u.Hostname(), "443")
+	}
+	return net.JoinHostPort(u.Hostname(), "80")
+}
+
+func wsHandshake(conn net.Conn, u *url.URL, header http.Header) (*wsConn, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Path: u.Path, RawQuery: u.RawQuery},
+		Host:       u.Host,
+		Header:     header.Clone(),
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+	}
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, fmt.Errorf("websocket handshake failed. Status code: %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != wsAcceptKey(key) {
+		return nil, errors.New("websocket handshake failed: invalid Sec-WebSocket-Accept header")
+	}
+	return &wsConn{conn: conn, reader: reader, client: true}, nil
+}
+
+func wsAcceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + wsGUID))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	header := make([]byte, 2, 14)
+	header[0] = 0x80 | opcode
+	switch {
+	case len(payload) < 126:
+		header[1] = byte(len(payload))
+	case len(payload) <= 0xffff:
+		header[1] = 126
+		header = binary.BigEndian.AppendUint16(header, uint16(len(payload)))
+	default:
+		header[1] = 127
+		header = binary.BigEndian.AppendUint64(header, uint64(len(payload)))
+	}
+
+	if c.client {
+		header[1] |= 0x80
+		mask := make([]byte, 4)
+		if _, err := rand.Read(mask); err != nil {
+			return err
+		}
+		header = append(header, mask...)
+		masked := make([]byte, len(payload))
+		for i := range payload {
+			masked[i] = payload[i] ^ mask[i%4]
+		}
+		payload = masked
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := c.conn.Write(append(header, payload...))
+	return err
+}
+
+func (c *wsConn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	if c.readTimeout > 0 {
+		c.conn.SetReadDeadline(time.Now().Add(c.readTimeout))
+	}
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+	//No extension is negotiated, so the reserved bits must be clear
+	if header[0]&0x70 != 0 {
+		return false, 0, nil, errors.New("websocket frame has reserved bits set")
+	}
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > wsMaxMessageSize {
+		return false, 0, nil, fmt.Errorf("websocket frame too large: %d bytes", length)
+	}
+	//Control frames may come between the fragments of a message, so they cannot be fragmented themselves
+	if opcode&0x8 != 0 && (!fin || length > 125) {
+		return false, 0, nil, fmt.Errorf("invalid websocket control frame: opcode %d", opcode)
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// Reads the next text or binary message, answering pings along the way, including those between its fragments.
+func (c *wsConn) readMessage() ([]byte, error) {
+	var message []byte
+	fragmented := false //Whether a message was started by a frame without fin
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch opcode {
+		case wsOpPing:
+			if err := c.writeFrame(wsOpPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case wsOpPong:
+			continue
+		case wsOpClose:
+			c.writeFrame(wsOpClose, payload)
+			return nil, errWsClosed
+		case wsOpText, wsOpBinary:
+			if fragmented {
+				return nil, errors.New("websocket message started before the previous one was complete")
+			}
+		case wsOpContinuation:
+			if !fragmented {
+				return nil, errors.New("websocket continuation frame without a message to continue")
+			}
+		default:
+			return nil, fmt.Errorf("unexpected websocket opcode: %d", opcode)
+		}
+		fragmented = !fin
+
+		message = append(message, payload...)
+		if len(message) > wsMaxMessageSize {
+			return nil, fmt.Errorf("websocket message too large: %d bytes", len(message))
+		}
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+func (c *wsConn) writeMessage(message []byte) error {
+	return c.writeFrame(wsOpText, message)
+}
+
+func (c *wsConn) ping() error {
+	return c.writeFrame(wsOpPing, nil)
+}
+
+// Sends a close frame and closes the underlying connection.
+func (c *wsConn) close() error {
+	c.writeFrame(wsOpClose, []byte{0x03, 0xe8}) //1000: normal closure
+	return c.conn.Close()
+}
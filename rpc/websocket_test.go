@@ -0,0 +1,226 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+// Returns the two ends of an in-memory WebSocket connection.
+func newWsPipe(t *testing.T) (client *wsConn, server *wsConn) {
+	a, b := net.Pipe()
+	t.Cleanup(func() {
+		a.Close()
+		b.Close()
+	})
+	return &wsConn{conn: a, reader: bufio.NewReader(a), client: true}, &wsConn{conn: b, reader: bufio.NewReader(b)}
+}
+
+// Writes an unmasked frame as is, so frames writeFrame never produces can be sent.
+func writeRawFrame(t *testing.T, conn *wsConn, fin bool, opcode byte, payload []byte) {
+	header := []byte{opcode, byte(len(payload))}
+	if fin {
+		header[0] |= 0x80
+	}
+	if _, err := conn.conn.Write(append(header, payload...)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsFragmentation(t *testing.T) {
+	client, server := newWsPipe(t)
+	go func() {
+		writeRawFrame(t, server, false, wsOpText, []byte("Hel"))
+		writeRawFrame(t, server, false, wsOpContinuation, []byte("lo "))
+		writeRawFrame(t, server, true, wsOpContinuation, []byte("world"))
+	}()
+	message, err := client.readMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(message) != "Hello world" {
+		t.Fatal("Unexpected message", string(message))
+	}
+}
+
+func TestWsControlFrameBetweenFragments(t *testing.T) {
+	client, server := newWsPipe(t)
+	pong := make(chan []byte, 1)
+	go func() {
+		writeRawFrame(t, server, false, wsOpText, []byte("Hel"))
+		writeRawFrame(t, server, true, wsOpPing, []byte("ping"))
+		_, opcode, payload, err := server.readFrame()
+		if err != nil || opcode != wsOpPong {
+			t.Error("Expected a pong, got", opcode, err)
+		}
+		pong <- payload
+		writeRawFrame(t, server, true, wsOpPong, nil)
+		writeRawFrame(t, server, true, wsOpContinuation, []byte("lo"))
+	}()
+	message, err := client.readMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(message) != "Hello" {
+		t.Fatal("Unexpected message", string(message))
+	}
+	if payload := <-pong; string(payload) != "ping" {
+		t.Fatal("Expected the pong to echo the ping, got", string(payload))
+	}
+}
+
+func TestWsFrameLengths(t *testing.T) {
+	tests := []struct {
+		length int
+		header []byte //Length bytes of the header, mask bit excluded
+	}{
+		{0, []byte{0}},
+		{125, []byte{125}},
+		{126, []byte{126, 0, 126}},
+		{0xffff, []byte{126, 0xff, 0xff}},
+		{0x10000, []byte{127, 0, 0, 0, 0, 0, 1, 0, 0}},
+	}
+	for _, test := range tests {
+		client, server := newWsPipe(t)
+		payload := bytes.Repeat([]byte{'a'}, test.length)
+
+		//The server reads the raw frame, checking the length encoding, then echoes the payload
+		errs := make(chan error, 1)
+		go func() {
+			header := make([]byte, 1+len(test.header)+4)
+			if _, err := io.ReadFull(server.reader, header); err != nil {
+				errs <- err
+				return
+			}
+			header[1] &^= 0x80
+			if !bytes.Equal(header[1:1+len(test.header)], test.header) {
+				t.Error("Unexpected header of a", test.length, "byte frame", header)
+			}
+			mask := header[1+len(test.header):]
+			received := make([]byte, test.length)
+			if _, err := io.ReadFull(server.reader, received); err != nil {
+				errs <- err
+				return
+			}
+			for i := range received {
+				received[i] ^= mask[i%4]
+			}
+			errs <- server.writeMessage(received)
+		}()
+
+		if err := client.writeMessage(payload); err != nil {
+			t.Fatal(err)
+		}
+		message, err := client.readMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(message, payload) {
+			t.Fatal("Expected a", test.length, "byte message, got", len(message))
+		}
+	}
+
+	//A 64-bit length beyond the maximum message size is refused before the payload is read
+	client, server := newWsPipe(t)
+	go server.conn.Write(binary.BigEndian.AppendUint64([]byte{0x80 | wsOpText, 127}, wsMaxMessageSize+1))
+	if _, err := client.readMessage(); err == nil {
+		t.Fatal("Expected a frame larger than the maximum message size to fail")
+	}
+}
+
+func TestWsInvalidFrames(t *testing.T) {
+	tests := []struct {
+		name   string
+		frames func(t *testing.T, server *wsConn)
+	}{
+		{"stray continuation frame", func(t *testing.T, server *wsConn) {
+			writeRawFrame(t, server, true, wsOpContinuation, []byte("lo"))
+		}},
+		{"new message before the previous one is complete", func(t *testing.T, server *wsConn) {
+			writeRawFrame(t, server, false, wsOpText, []byte("Hel"))
+			writeRawFrame(t, server, true, wsOpText, []byte("lo"))
+		}},
+		{"fragmented control frame", func(t *testing.T, server *wsConn) {
+			writeRawFrame(t, server, false, wsOpPing, []byte("ping"))
+		}},
+		{"reserved bits", func(t *testing.T, server *wsConn) {
+			writeRawFrame(t, server, true, 0x40|wsOpText, []byte("Hello"))
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, server := newWsPipe(t)
+			go func() {
+				test.frames(t, server)
+				server.conn.Close()
+			}()
+			if message, err := client.readMessage(); err == nil || err == io.EOF {
+				t.Fatal("Expected a protocol error, got", string(message), err)
+			}
+		})
+	}
+}
+
+// Stands in for an HTTP proxy tunneling CONNECT requests. Counts the tunnels it opened.
+func newConnectProxy(t *testing.T, tunnels *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "expected CONNECT", http.StatusMethodNotAllowed)
+			return
+		}
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		conn, rw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		tunnels.Add(1)
+		rw.WriteString("HTTP/1.1 200 Connection established\r\n\r\n")
+		rw.Flush()
+		go func() {
+			io.Copy(upstream, rw)
+			upstream.Close()
+		}()
+		io.Copy(conn, upstream)
+		conn.Close()
+	}))
+}
+
+func TestWsProxy(t *testing.T) {
+	server := newWsTestServer(t)
+	defer server.Close()
+	var tunnels atomic.Int32
+	proxy := newConnectProxy(t, &tunnels)
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	httpClient := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+	client, err := NewWsClient(context.Background(), server.endpoint(), WithWsHTTPClient(httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, err := client.SlotSubscribe(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	server.expectRequest(t, "slotSubscribe")
+	if tunnels.Load() != 1 {
+		t.Fatal("Expected the connection to go through the proxy, got", tunnels.Load(), "tunnels")
+	}
+}
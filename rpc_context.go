@@ -0,0 +1,671 @@
+package solana
+
+import (
+	"context"
+	"time"
+)
+
+// RpcContext is the context-aware counterpart of Rpc. Every method takes a context.Context as its first argument which controls cancellation and deadlines of the underlying request.
+type RpcContext interface {
+	GetAccountInfo(ctx context.Context, address Pubkey, config ...GetAccountInfoConfig) (*Account, error)                                                             //Returns all information associated with the account of provided Pubkey
+	GetBalance(ctx context.Context, address Pubkey, config ...StandardRpcConfig) (uint, error)                                                                        //Returns the lamport balance of the account of provided Pubkey
+	GetBlock(ctx context.Context, slotNumber uint, config ...GetBlockConfig) (*Block, error)                                                                          //Returns identity and transaction information about a confirmed block in the ledger
+	GetBlockCommitment(ctx context.Context, slotNumber uint) (BlockCommitment, error)                                                                                 //Returns the current block height and the estimated production time of a block
+	GetBlockHeight(ctx context.Context, config ...StandardRpcConfig) (uint, error)                                                                                    //Returns the current block height of the node
+	GetBlockProduction(ctx context.Context, config ...GetBlockProductionConfig) (BlockProduction, error)                                                              //Returns recent block production information from the current or previous epoch.
+	GetBlockTime(ctx context.Context, slotNumber uint) (time.Time, error)                                                                                             //Returns the estimated production time of a block as a unix timestamp.
+	GetBlocks(ctx context.Context, startSlot uint, endSlot *uint, config ...GetBlockConfig) ([]uint, error)                                                           //Returns a list of confirmed blocks between two slots
+	GetBlocksWithLimit(ctx context.Context, startSlot uint, limit uint, config ...GetBlockConfig) ([]uint, error)                                                     //Returns a list of confirmed blocks starting at the given slot
+	GetClusterNodes(ctx context.Context) ([]ClusterNode, error)                                                                                                       //Returns information about all the nodes participating in the cluster
+	GetEpochInfo(ctx context.Context, config ...StandardRpcConfig) (EpochInfo, error)                                                                                 //Returns information about the current epoch
+	GetEpochSchedule(ctx context.Context) (EpochSchedule, error)                                                                                                      //Returns the epoch schedule information from this cluster's genesis config
+	GetFeeForMessage(ctx context.Context, msg []byte, config ...StandardRpcConfig) (*uint, error)                                                                     //Get the fee the network will charge for a particular Message. NOTE: This method is only available in solana-core v1.9 or newer. Please use getFees for solana-core v1.8 and below.
+	GetFirstAvailableBlock(ctx context.Context) (uint, error)                                                                                                         //Returns the slot of the lowest confirmed block that has not been purged from the ledger
+	GetGenesisHash(ctx context.Context) (string, error)                                                                                                               //Returns the genesis hash
+	GetHealth(ctx context.Context) error                                                                                                                              //Returns the current health of the node. A healthy node is one that is within HEALTH_CHECK_SLOT_DISTANCE slots of the latest cluster confirmed slot.
+	GetHighestSnapshotSlot(ctx context.Context) (HighestSnapshotSlot, error)                                                                                          //Returns the highest slot information that the node has snapshots for. This will find the highest full snapshot slot, and the highest incremental snapshot slot based on the full snapshot slot, if there is one.
+	GetIdentity(ctx context.Context) (Pubkey, error)                                                                                                                  //Returns the identity pubkey for the current node
+	GetInflationGovernor(ctx context.Context, config ...StandardCommitmentConfig) (InflationGovernor, error)                                                          //Returns the current inflation governor
+	GetInflationRate(ctx context.Context, config ...StandardCommitmentConfig) (InflationRate, error)                                                                  //Returns the specific inflation values for the current epoch
+	GetInflationReward(ctx context.Context, addresses []Pubkey, config ...GetInflationRewardConfig) ([]*InflationReward, error)                                       //Returns the inflation / staking reward for a list of addresses for an epoch
+	GetLargestAccounts(ctx context.Context, config ...GetLargestAccountsConfig) ([]AccountWithBalance, error)                                                         //Returns the 20 largest accounts, by lamport balance (results may be cached up to two hours)
+	GetLatestBlockhash(ctx context.Context, config ...StandardRpcConfig) (LatestBlockhash, error)                                                                     //Returns the latest blockhash. NOTE: This method is only available in solana-core v1.9 or newer. Please use getRecentBlockhash for solana-core v1.8 and below.
+	GetLeaderSchedule(ctx context.Context, slot *uint, config ...GetLeaderScheduleConfig) (*LeaderSchedule, error)                                                    //Returns the leader schedule for an epoch
+	GetMaxRetransmitSlot(ctx context.Context) (uint, error)                                                                                                           //Get the max slot seen from retransmit stage.
+	GetMaxShredInsertSlot(ctx context.Context) (uint, error)                                                                                                          //Get the max slot seen from after shred insert.
+	GetMinimumBalanceForRentExemption(ctx context.Context, accountDataLength uint, config ...StandardCommitmentConfig) (uint, error)                                  //Returns minimum balance required to make account rent exempt.
+	GetMultipleAccounts(ctx context.Context, pubkeys []Pubkey, config ...GetAccountInfoConfig) ([]*Account, error)                                                    //Returns the account information for a list of Pubkeys.
+	GetProgramAccounts(ctx context.Context, programPubkey Pubkey, config ...GetAccountInfoConfig) ([]Account, error)                                                  //Returns all accounts owned by the provided program Pubkey
+	GetRecentPerformanceSamples(ctx context.Context, limit uint) ([]PerformanceSample, error)                                                                         //Returns a list of recent performance samples, in reverse slot order. Performance samples are taken every 60 seconds and include the number of transactions and slots that occur in a given time window. -- NOTE max limit is 720
+	GetRecentPrioritizationFees(ctx context.Context, addresses []Pubkey) ([]PrioritizationFee, error)                                                                 //Returns a list of prioritization fees from recent blocks.
+	GetSignatureStatuses(ctx context.Context, signatures []string, config ...GetSignatureStatusesConfig) ([]*SignatureStatus, error)                                  //Returns the statuses of a list of signatures. Each signature must be a txid, the first signature of a transaction. Unless the searchTransactionHistory configuration parameter is included, this method only searches the recent status cache of signatures, which retains statuses for all active slots plus MAX_RECENT_BLOCKHASHES rooted slots.
+	GetSignaturesForAddress(ctx context.Context, address Pubkey, config ...GetSignaturesForAddressConfig) ([]TransactionSignature, error)                             //Returns signatures for confirmed transactions that include the given address in their accountKeys list. Returns signatures backwards in time from the provided signature or most recent confirmed block
+	GetSlot(ctx context.Context, config ...StandardRpcConfig) (uint, error)                                                                                           //Returns the slot that has reached the given or default commitment level
+	GetSlotLeader(ctx context.Context, config ...StandardRpcConfig) (Pubkey, error)                                                                                   //Returns the current slot leader
+	GetSlotLeaders(ctx context.Context, start *uint, limit *uint) ([]Pubkey, error)                                                                                   //Returns the slot leaders for a given slot range
+	GetStakeMinimumDelegation(ctx context.Context, config ...StandardCommitmentConfig) (uint, error)                                                                  //Returns the stake minimum delegation, in lamports.
+	GetSupply(ctx context.Context, config ...GetSupplyConfig) (Supply, error)                                                                                         //Returns information about the current supply.
+	GetTokenAccountBalance(ctx context.Context, address Pubkey, config ...StandardCommitmentConfig) (UiTokenAmount, error)                                            //Returns the token balance of an SPL Token account.
+	GetTokenAccountsByDelegate(ctx context.Context, delegateAddress Pubkey, opts GetTokenAccountsByDelegateConfig, config ...GetAccountInfoConfig) ([]Account, error) //Returns all SPL Token accounts by approved Delegate.
+	GetTokenAccountsByOwner(ctx context.Context, ownerAddress Pubkey, opts GetTokenAccountsByDelegateConfig, config ...GetAccountInfoConfig) ([]Account, error)       //Returns all SPL Token accounts by token owner.
+	GetTokenLargestAccounts(ctx context.Context, mintAddress Pubkey, config ...StandardCommitmentConfig) ([]UiTokenAmount, error)                                     //Returns the 20 largest accounts of a particular SPL Token type.
+	GetTokenSupply(ctx context.Context, mintAddress Pubkey, config ...StandardCommitmentConfig) (UiTokenAmount, error)                                                //Returns the total supply of an SPL Token type.
+	GetTransaction(ctx context.Context, transactionSignature string, config ...GetTransactionSignatureConfig) (*TransactionWithMeta, error)                           //Returns transaction details for a confirmed transaction
+	GetTransactionCount(ctx context.Context, config ...StandardRpcConfig) (uint, error)                                                                               //Returns the current transaction count from the ledger
+	GetVersion(ctx context.Context) (Version, error)                                                                                                                  //Returns the current Solana version running on the node
+	GetVoteAccounts(ctx context.Context, config ...GetVoteAccountsConfig) (VoteAccounts, error)                                                                       //Returns the account info and associated stake for all the voting accounts in the current bank.
+	IsBlockhashValid(ctx context.Context, blockhash string, config ...StandardRpcConfig) (bool, error)                                                                //Returns whether a blockhash is valid
+	MinimumLedgerSlot(ctx context.Context) (uint, error)                                                                                                              //Returns the lowest slot that the node has information about in its ledger.
+	RequestAirdrop(ctx context.Context, destinationAddress Pubkey, lamports uint, config ...StandardCommitmentConfig) (string, error)                                 //Requests an airdrop of lamports to a Solana account
+	SendTransaction(ctx context.Context, fullySignedTransaction string, config ...SendTransactionConfig) (string, error)                                              //Submits a signed transaction to the cluster for processing. See Rpc.SendTransaction for details.
+	SimulateTransaction(ctx context.Context, transaction string, config ...SimulateTransactionConfig) (SimulateTransactionResult, error)                              //Simulate sending a transaction. NOTE: Transaction needs a valid recent blockhash, but does not need to be signed
+	GetAsset(ctx context.Context, pubkey Pubkey, config ...GetAssetConfig) (GetAssetResult, error)
+}
+
+// BackgroundRpc adapts an RpcContext to the Rpc interface. Every call is made with context.Background().
+func BackgroundRpc(rpc RpcContext) Rpc {
+	if r, ok := rpc.(contextRpc); ok {
+		return r.rpc
+	}
+	return backgroundRpc{rpc: rpc}
+}
+
+// ContextRpc adapts an Rpc to the RpcContext interface. If rpc was created with BackgroundRpc the original RpcContext is returned.
+// Otherwise the context is only checked before each call is made, as a plain Rpc cannot be interrupted once a request is in flight.
+func ContextRpc(rpc Rpc) RpcContext {
+	if r, ok := rpc.(backgroundRpc); ok {
+		return r.rpc
+	}
+	return contextRpc{rpc: rpc}
+}
+
+type backgroundRpc struct {
+	rpc RpcContext
+}
+
+func (r backgroundRpc) GetAccountInfo(address Pubkey, config ...GetAccountInfoConfig) (*Account, error) {
+	return r.rpc.GetAccountInfo(context.Background(), address, config...)
+}
+
+func (r backgroundRpc) GetBalance(address Pubkey, config ...StandardRpcConfig) (uint, error) {
+	return r.rpc.GetBalance(context.Background(), address, config...)
+}
+
+func (r backgroundRpc) GetBlock(slotNumber uint, config ...GetBlockConfig) (*Block, error) {
+	return r.rpc.GetBlock(context.Background(), slotNumber, config...)
+}
+
+func (r backgroundRpc) GetBlockCommitment(slotNumber uint) (BlockCommitment, error) {
+	return r.rpc.GetBlockCommitment(context.Background(), slotNumber)
+}
+
+func (r backgroundRpc) GetBlockHeight(config ...StandardRpcConfig) (uint, error) {
+	return r.rpc.GetBlockHeight(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetBlockProduction(config ...GetBlockProductionConfig) (BlockProduction, error) {
+	return r.rpc.GetBlockProduction(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetBlockTime(slotNumber uint) (time.Time, error) {
+	return r.rpc.GetBlockTime(context.Background(), slotNumber)
+}
+
+func (r backgroundRpc) GetBlocks(startSlot uint, endSlot *uint, config ...GetBlockConfig) ([]uint, error) {
+	return r.rpc.GetBlocks(context.Background(), startSlot, endSlot, config...)
+}
+
+func (r backgroundRpc) GetBlocksWithLimit(startSlot uint, limit uint, config ...GetBlockConfig) ([]uint, error) {
+	return r.rpc.GetBlocksWithLimit(context.Background(), startSlot, limit, config...)
+}
+
+func (r backgroundRpc) GetClusterNodes() ([]ClusterNode, error) {
+	return r.rpc.GetClusterNodes(context.Background())
+}
+
+func (r backgroundRpc) GetEpochInfo(config ...StandardRpcConfig) (EpochInfo, error) {
+	return r.rpc.GetEpochInfo(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetEpochSchedule() (EpochSchedule, error) {
+	return r.rpc.GetEpochSchedule(context.Background())
+}
+
+func (r backgroundRpc) GetFeeForMessage(msg []byte, config ...StandardRpcConfig) (*uint, error) {
+	return r.rpc.GetFeeForMessage(context.Background(), msg, config...)
+}
+
+func (r backgroundRpc) GetFirstAvailableBlock() (uint, error) {
+	return r.rpc.GetFirstAvailableBlock(context.Background())
+}
+
+func (r backgroundRpc) GetGenesisHash() (string, error) {
+	return r.rpc.GetGenesisHash(context.Background())
+}
+
+func (r backgroundRpc) GetHealth() error {
+	return r.rpc.GetHealth(context.Background())
+}
+
+func (r backgroundRpc) GetHighestSnapshotSlot() (HighestSnapshotSlot, error) {
+	return r.rpc.GetHighestSnapshotSlot(context.Background())
+}
+
+func (r backgroundRpc) GetIdentity() (Pubkey, error) {
+	return r.rpc.GetIdentity(context.Background())
+}
+
+func (r backgroundRpc) GetInflationGovernor(config ...StandardCommitmentConfig) (InflationGovernor, error) {
+	return r.rpc.GetInflationGovernor(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetInflationRate(config ...StandardCommitmentConfig) (InflationRate, error) {
+	return r.rpc.GetInflationRate(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetInflationReward(addresses []Pubkey, config ...GetInflationRewardConfig) ([]*InflationReward, error) {
+	return r.rpc.GetInflationReward(context.Background(), addresses, config...)
+}
+
+func (r backgroundRpc) GetLargestAccounts(config ...GetLargestAccountsConfig) ([]AccountWithBalance, error) {
+	return r.rpc.GetLargestAccounts(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetLatestBlockhash(config ...StandardRpcConfig) (LatestBlockhash, error) {
+	return r.rpc.GetLatestBlockhash(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetLeaderSchedule(slot *uint, config ...GetLeaderScheduleConfig) (*LeaderSchedule, error) {
+	return r.rpc.GetLeaderSchedule(context.Background(), slot, config...)
+}
+
+func (r backgroundRpc) GetMaxRetransmitSlot() (uint, error) {
+	return r.rpc.GetMaxRetransmitSlot(context.Background())
+}
+
+func (r backgroundRpc) GetMaxShredInsertSlot() (uint, error) {
+	return r.rpc.GetMaxShredInsertSlot(context.Background())
+}
+
+func (r backgroundRpc) GetMinimumBalanceForRentExemption(accountDataLength uint, config ...StandardCommitmentConfig) (uint, error) {
+	return r.rpc.GetMinimumBalanceForRentExemption(context.Background(), accountDataLength, config...)
+}
+
+func (r backgroundRpc) GetMultipleAccounts(pubkeys []Pubkey, config ...GetAccountInfoConfig) ([]*Account, error) {
+	return r.rpc.GetMultipleAccounts(context.Background(), pubkeys, config...)
+}
+
+func (r backgroundRpc) GetProgramAccounts(programPubkey Pubkey, config ...GetAccountInfoConfig) ([]Account, error) {
+	return r.rpc.GetProgramAccounts(context.Background(), programPubkey, config...)
+}
+
+func (r backgroundRpc) GetRecentPerformanceSamples(limit uint) ([]PerformanceSample, error) {
+	return r.rpc.GetRecentPerformanceSamples(context.Background(), limit)
+}
+
+func (r backgroundRpc) GetRecentPrioritizationFees(addresses []Pubkey) ([]PrioritizationFee, error) {
+	return r.rpc.GetRecentPrioritizationFees(context.Background(), addresses)
+}
+
+func (r backgroundRpc) GetSignatureStatuses(signatures []string, config ...GetSignatureStatusesConfig) ([]*SignatureStatus, error) {
+	return r.rpc.GetSignatureStatuses(context.Background(), signatures, config...)
+}
+
+func (r backgroundRpc) GetSignaturesForAddress(address Pubkey, config ...GetSignaturesForAddressConfig) ([]TransactionSignature, error) {
+	return r.rpc.GetSignaturesForAddress(context.Background(), address, config...)
+}
+
+func (r backgroundRpc) GetSlot(config ...StandardRpcConfig) (uint, error) {
+	return r.rpc.GetSlot(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetSlotLeader(config ...StandardRpcConfig) (Pubkey, error) {
+	return r.rpc.GetSlotLeader(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetSlotLeaders(start *uint, limit *uint) ([]Pubkey, error) {
+	return r.rpc.GetSlotLeaders(context.Background(), start, limit)
+}
+
+func (r backgroundRpc) GetStakeMinimumDelegation(config ...StandardCommitmentConfig) (uint, error) {
+	return r.rpc.GetStakeMinimumDelegation(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetSupply(config ...GetSupplyConfig) (Supply, error) {
+	return r.rpc.GetSupply(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetTokenAccountBalance(address Pubkey, config ...StandardCommitmentConfig) (UiTokenAmount, error) {
+	return r.rpc.GetTokenAccountBalance(context.Background(), address, config...)
+}
+
+func (r backgroundRpc) GetTokenAccountsByDelegate(delegateAddress Pubkey, opts GetTokenAccountsByDelegateConfig, config ...GetAccountInfoConfig) ([]Account, error) {
+	return r.rpc.GetTokenAccountsByDelegate(context.Background(), delegateAddress, opts, config...)
+}
+
+func (r backgroundRpc) GetTokenAccountsByOwner(ownerAddress Pubkey, opts GetTokenAccountsByDelegateConfig, config ...GetAccountInfoConfig) ([]Account, error) {
+	return r.rpc.GetTokenAccountsByOwner(context.Background(), ownerAddress, opts, config...)
+}
+
+func (r backgroundRpc) GetTokenLargestAccounts(mintAddress Pubkey, config ...StandardCommitmentConfig) ([]UiTokenAmount, error) {
+	return r.rpc.GetTokenLargestAccounts(context.Background(), mintAddress, config...)
+}
+
+func (r backgroundRpc) GetTokenSupply(mintAddress Pubkey, config ...StandardCommitmentConfig) (UiTokenAmount, error) {
+	return r.rpc.GetTokenSupply(context.Background(), mintAddress, config...)
+}
+
+func (r backgroundRpc) GetTransaction(transactionSignature string, config ...GetTransactionSignatureConfig) (*TransactionWithMeta, error) {
+	return r.rpc.GetTransaction(context.Background(), transactionSignature, config...)
+}
+
+func (r backgroundRpc) GetTransactionCount(config ...StandardRpcConfig) (uint, error) {
+	return r.rpc.GetTransactionCount(context.Background(), config...)
+}
+
+func (r backgroundRpc) GetVersion() (Version, error) {
+	return r.rpc.GetVersion(context.Background())
+}
+
+func (r backgroundRpc) GetVoteAccounts(config ...GetVoteAccountsConfig) (VoteAccounts, error) {
+	return r.rpc.GetVoteAccounts(context.Background(), config...)
+}
+
+func (r backgroundRpc) IsBlockhashValid(blockhash string, config ...StandardRpcConfig) (bool, error) {
+	return r.rpc.IsBlockhashValid(context.Background(), blockhash, config...)
+}
+
+func (r backgroundRpc) MinimumLedgerSlot() (uint, error) {
+	return r.rpc.MinimumLedgerSlot(context.Background())
+}
+
+func (r backgroundRpc) RequestAirdrop(destinationAddress Pubkey, lamports uint, config ...StandardCommitmentConfig) (string, error) {
+	return r.rpc.RequestAirdrop(context.Background(), destinationAddress, lamports, config...)
+}
+
+func (r backgroundRpc) SendTransaction(fullySignedTransaction string, config ...SendTransactionConfig) (string, error) {
+	return r.rpc.SendTransaction(context.Background(), fullySignedTransaction, config...)
+}
+
+func (r backgroundRpc) SimulateTransaction(transaction string, config ...SimulateTransactionConfig) (SimulateTransactionResult, error) {
+	return r.rpc.SimulateTransaction(context.Background(), transaction, config...)
+}
+
+func (r backgroundRpc) GetAsset(pubkey Pubkey, config ...GetAssetConfig) (GetAssetResult, error) {
+	return r.rpc.GetAsset(context.Background(), pubkey, config...)
+}
+
+type contextRpc struct {
+	rpc Rpc
+}
+
+func (r contextRpc) GetAccountInfo(ctx context.Context, address Pubkey, config ...GetAccountInfoConfig) (*Account, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetAccountInfo(address, config...)
+}
+
+func (r contextRpc) GetBalance(ctx context.Context, address Pubkey, config ...StandardRpcConfig) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetBalance(address, config...)
+}
+
+func (r contextRpc) GetBlock(ctx context.Context, slotNumber uint, config ...GetBlockConfig) (*Block, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetBlock(slotNumber, config...)
+}
+
+func (r contextRpc) GetBlockCommitment(ctx context.Context, slotNumber uint) (BlockCommitment, error) {
+	if err := ctx.Err(); err != nil {
+		return BlockCommitment{}, err
+	}
+	return r.rpc.GetBlockCommitment(slotNumber)
+}
+
+func (r contextRpc) GetBlockHeight(ctx context.Context, config ...StandardRpcConfig) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetBlockHeight(config...)
+}
+
+func (r contextRpc) GetBlockProduction(ctx context.Context, config ...GetBlockProductionConfig) (BlockProduction, error) {
+	if err := ctx.Err(); err != nil {
+		return BlockProduction{}, err
+	}
+	return r.rpc.GetBlockProduction(config...)
+}
+
+func (r contextRpc) GetBlockTime(ctx context.Context, slotNumber uint) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return r.rpc.GetBlockTime(slotNumber)
+}
+
+func (r contextRpc) GetBlocks(ctx context.Context, startSlot uint, endSlot *uint, config ...GetBlockConfig) ([]uint, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetBlocks(startSlot, endSlot, config...)
+}
+
+func (r contextRpc) GetBlocksWithLimit(ctx context.Context, startSlot uint, limit uint, config ...GetBlockConfig) ([]uint, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetBlocksWithLimit(startSlot, limit, config...)
+}
+
+func (r contextRpc) GetClusterNodes(ctx context.Context) ([]ClusterNode, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetClusterNodes()
+}
+
+func (r contextRpc) GetEpochInfo(ctx context.Context, config ...StandardRpcConfig) (EpochInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return EpochInfo{}, err
+	}
+	return r.rpc.GetEpochInfo(config...)
+}
+
+func (r contextRpc) GetEpochSchedule(ctx context.Context) (EpochSchedule, error) {
+	if err := ctx.Err(); err != nil {
+		return EpochSchedule{}, err
+	}
+	return r.rpc.GetEpochSchedule()
+}
+
+func (r contextRpc) GetFeeForMessage(ctx context.Context, msg []byte, config ...StandardRpcConfig) (*uint, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetFeeForMessage(msg, config...)
+}
+
+func (r contextRpc) GetFirstAvailableBlock(ctx context.Context) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetFirstAvailableBlock()
+}
+
+func (r contextRpc) GetGenesisHash(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return r.rpc.GetGenesisHash()
+}
+
+func (r contextRpc) GetHealth(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return r.rpc.GetHealth()
+}
+
+func (r contextRpc) GetHighestSnapshotSlot(ctx context.Context) (HighestSnapshotSlot, error) {
+	if err := ctx.Err(); err != nil {
+		return HighestSnapshotSlot{}, err
+	}
+	return r.rpc.GetHighestSnapshotSlot()
+}
+
+func (r contextRpc) GetIdentity(ctx context.Context) (Pubkey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetIdentity()
+}
+
+func (r contextRpc) GetInflationGovernor(ctx context.Context, config ...StandardCommitmentConfig) (InflationGovernor, error) {
+	if err := ctx.Err(); err != nil {
+		return InflationGovernor{}, err
+	}
+	return r.rpc.GetInflationGovernor(config...)
+}
+
+func (r contextRpc) GetInflationRate(ctx context.Context, config ...StandardCommitmentConfig) (InflationRate, error) {
+	if err := ctx.Err(); err != nil {
+		return InflationRate{}, err
+	}
+	return r.rpc.GetInflationRate(config...)
+}
+
+func (r contextRpc) GetInflationReward(ctx context.Context, addresses []Pubkey, config ...GetInflationRewardConfig) ([]*InflationReward, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetInflationReward(addresses, config...)
+}
+
+func (r contextRpc) GetLargestAccounts(ctx context.Context, config ...GetLargestAccountsConfig) ([]AccountWithBalance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetLargestAccounts(config...)
+}
+
+func (r contextRpc) GetLatestBlockhash(ctx context.Context, config ...StandardRpcConfig) (LatestBlockhash, error) {
+	if err := ctx.Err(); err != nil {
+		return LatestBlockhash{}, err
+	}
+	return r.rpc.GetLatestBlockhash(config...)
+}
+
+func (r contextRpc) GetLeaderSchedule(ctx context.Context, slot *uint, config ...GetLeaderScheduleConfig) (*LeaderSchedule, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetLeaderSchedule(slot, config...)
+}
+
+func (r contextRpc) GetMaxRetransmitSlot(ctx context.Context) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetMaxRetransmitSlot()
+}
+
+func (r contextRpc) GetMaxShredInsertSlot(ctx context.Context) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetMaxShredInsertSlot()
+}
+
+func (r contextRpc) GetMinimumBalanceForRentExemption(ctx context.Context, accountDataLength uint, config ...StandardCommitmentConfig) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetMinimumBalanceForRentExemption(accountDataLength, config...)
+}
+
+func (r contextRpc) GetMultipleAccounts(ctx context.Context, pubkeys []Pubkey, config ...GetAccountInfoConfig) ([]*Account, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetMultipleAccounts(pubkeys, config...)
+}
+
+func (r contextRpc) GetProgramAccounts(ctx context.Context, programPubkey Pubkey, config ...GetAccountInfoConfig) ([]Account, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetProgramAccounts(programPubkey, config...)
+}
+
+func (r contextRpc) GetRecentPerformanceSamples(ctx context.Context, limit uint) ([]PerformanceSample, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetRecentPerformanceSamples(limit)
+}
+
+func (r contextRpc) GetRecentPrioritizationFees(ctx context.Context, addresses []Pubkey) ([]PrioritizationFee, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetRecentPrioritizationFees(addresses)
+}
+
+func (r contextRpc) GetSignatureStatuses(ctx context.Context, signatures []string, config ...GetSignatureStatusesConfig) ([]*SignatureStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetSignatureStatuses(signatures, config...)
+}
+
+func (r contextRpc) GetSignaturesForAddress(ctx context.Context, address Pubkey, config ...GetSignaturesForAddressConfig) ([]TransactionSignature, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetSignaturesForAddress(address, config...)
+}
+
+func (r contextRpc) GetSlot(ctx context.Context, config ...StandardRpcConfig) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetSlot(config...)
+}
+
+func (r contextRpc) GetSlotLeader(ctx context.Context, config ...StandardRpcConfig) (Pubkey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetSlotLeader(config...)
+}
+
+func (r contextRpc) GetSlotLeaders(ctx context.Context, start *uint, limit *uint) ([]Pubkey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetSlotLeaders(start, limit)
+}
+
+func (r contextRpc) GetStakeMinimumDelegation(ctx context.Context, config ...StandardCommitmentConfig) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetStakeMinimumDelegation(config...)
+}
+
+func (r contextRpc) GetSupply(ctx context.Context, config ...GetSupplyConfig) (Supply, error) {
+	if err := ctx.Err(); err != nil {
+		return Supply{}, err
+	}
+	return r.rpc.GetSupply(config...)
+}
+
+func (r contextRpc) GetTokenAccountBalance(ctx context.Context, address Pubkey, config ...StandardCommitmentConfig) (UiTokenAmount, error) {
+	if err := ctx.Err(); err != nil {
+		return UiTokenAmount{}, err
+	}
+	return r.rpc.GetTokenAccountBalance(address, config...)
+}
+
+func (r contextRpc) GetTokenAccountsByDelegate(ctx context.Context, delegateAddress Pubkey, opts GetTokenAccountsByDelegateConfig, config ...GetAccountInfoConfig) ([]Account, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetTokenAccountsByDelegate(delegateAddress, opts, config...)
+}
+
+func (r contextRpc) GetTokenAccountsByOwner(ctx context.Context, ownerAddress Pubkey, opts GetTokenAccountsByDelegateConfig, config ...GetAccountInfoConfig) ([]Account, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetTokenAccountsByOwner(ownerAddress, opts, config...)
+}
+
+func (r contextRpc) GetTokenLargestAccounts(ctx context.Context, mintAddress Pubkey, config ...StandardCommitmentConfig) ([]UiTokenAmount, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetTokenLargestAccounts(mintAddress, config...)
+}
+
+func (r contextRpc) GetTokenSupply(ctx context.Context, mintAddress Pubkey, config ...StandardCommitmentConfig) (UiTokenAmount, error) {
+	if err := ctx.Err(); err != nil {
+		return UiTokenAmount{}, err
+	}
+	return r.rpc.GetTokenSupply(mintAddress, config...)
+}
+
+func (r contextRpc) GetTransaction(ctx context.Context, transactionSignature string, config ...GetTransactionSignatureConfig) (*TransactionWithMeta, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.rpc.GetTransaction(transactionSignature, config...)
+}
+
+func (r contextRpc) GetTransactionCount(ctx context.Context, config ...StandardRpcConfig) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.GetTransactionCount(config...)
+}
+
+func (r contextRpc) GetVersion(ctx context.Context) (Version, error) {
+	if err := ctx.Err(); err != nil {
+		return Version{}, err
+	}
+	return r.rpc.GetVersion()
+}
+
+func (r contextRpc) GetVoteAccounts(ctx context.Context, config ...GetVoteAccountsConfig) (VoteAccounts, error) {
+	if err := ctx.Err(); err != nil {
+		return VoteAccounts{}, err
+	}
+	return r.rpc.GetVoteAccounts(config...)
+}
+
+func (r contextRpc) IsBlockhashValid(ctx context.Context, blockhash string, config ...StandardRpcConfig) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return r.rpc.IsBlockhashValid(blockhash, config...)
+}
+
+func (r contextRpc) MinimumLedgerSlot(ctx context.Context) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.rpc.MinimumLedgerSlot()
+}
+
+func (r contextRpc) RequestAirdrop(ctx context.Context, destinationAddress Pubkey, lamports uint, config ...StandardCommitmentConfig) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return r.rpc.RequestAirdrop(destinationAddress, lamports, config...)
+}
+
+func (r contextRpc) SendTransaction(ctx context.Context, fullySignedTransaction string, config ...SendTransactionConfig) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return r.rpc.SendTransaction(fullySignedTransaction, config...)
+}
+
+func (r contextRpc) SimulateTransaction(ctx context.Context, transaction string, config ...SimulateTransactionConfig) (SimulateTransactionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return SimulateTransactionResult{}, err
+	}
+	return r.rpc.SimulateTransaction(transaction, config...)
+}
+
+func (r contextRpc) GetAsset(ctx context.Context, pubkey Pubkey, config ...GetAssetConfig) (GetAssetResult, error) {
+	if err := ctx.Err(); err != nil {
+		return GetAssetResult{}, err
+	}
+	return r.rpc.GetAsset(pubkey, config...)
+}
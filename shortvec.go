@@ -0,0 +1,43 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Largest length a compact-u16 can hold.
+const maxShortVecLength = 0xffff
+
+// Appends n encoded as a compact-u16, the variable length encoding of every length prefix in the wire format of transactions: 7 bits per byte, least significant first, with the high bit set on every byte but the last.
+func appendShortVecLength(data []byte, n int) ([]byte, error) {
+	if n < 0 || n > maxShortVecLength {
+		return nil, fmt.Errorf("length %d does not fit in a compact-u16", n)
+	}
+	for n >= 0x80 {
+		data = append(data, byte(n&0x7f)|0x80)
+		n >>= 7
+	}
+	return append(data, byte(n)), nil
+}
+
+// Reads a compact-u16 from the start of data and returns it with the remaining data. Encodings longer than needed are rejected, like the runtime does.
+func readShortVecLength(data []byte) (int, []byte, error) {
+	var n int
+	for i := 0; i < 3; i++ {
+		if len(data) <= i {
+			return 0, nil, errors.New("not enough data to read compact-u16")
+		}
+		b := data[i]
+		if i == 2 && b > 0x03 {
+			return 0, nil, errors.New("compact-u16 overflows u16")
+		}
+		if i > 0 && b == 0 {
+			return 0, nil, errors.New("compact-u16 is not canonically encoded")
+		}
+		n |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return n, data[i+1:], nil
+		}
+	}
+	return 0, nil, errors.New("compact-u16 overflows u16")
+}
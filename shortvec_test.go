@@ -0,0 +1,153 @@
+package solana
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestShortVec(t *testing.T) {
+	tests := map[int][]byte{
+		0:      {0x00},
+		0x7f:   {0x7f},
+		0x80:   {0x80, 0x01},
+		0xff:   {0xff, 0x01},
+		0x100:  {0x80, 0x02},
+		0x3fff: {0xff, 0x7f},
+		0x4000: {0x80, 0x80, 0x01},
+		0xffff: {0xff, 0xff, 0x03},
+	}
+	for n, expected := range tests {
+		data, err := appendShortVecLength(nil, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slices.Compare(data, expected) != 0 {
+			t.Fatal("Unexpected encoding of", n, data)
+		}
+		decoded, remaining, err := readShortVecLength(append(data, 0xaa))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded != n || slices.Compare(remaining, []byte{0xaa}) != 0 {
+			t.Fatal("Unexpected decoding of", n, decoded, remaining)
+		}
+	}
+
+	if _, err := appendShortVecLength(nil, 0x10000); err == nil {
+		t.Fatal("Expected lengths above u16 to be rejected")
+	}
+	invalid := [][]byte{
+		{},                 //Empty
+		{0x80},             //Truncated
+		{0x80, 0x00},       //Non-canonical zero continuation
+		{0xff, 0xff, 0x04}, //Overflows u16
+		{0x80, 0x80, 0x80}, //Too long
+	}
+	for _, data := range invalid {
+		if _, _, err := readShortVecLength(data); err == nil {
+			t.Fatal("Expected an error decoding", data)
+		}
+	}
+}
+
+func TestLongInstructionData(t *testing.T) {
+	payer := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	memo := make([]byte, 300)
+	for i := range memo {
+		memo[i] = byte(i)
+	}
+	tx := Transaction{
+		Signatures: []string{"5WUMzKkDaSuLUj3RpbufHi2PLRPgkjkHhsJpLE7Q3a3fMNDkV579zDmWLfMTnw4my5cbHKicRhYDTQsoAidv8nYD"},
+		Message: Message{
+			Instructions: []Instruction{{
+				Accounts:  []AccountMeta{{Pubkey: payer, Signer: true, Writable: true}},
+				Data:      memo,
+				ProgramID: MustParsePubkey("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr"),
+			}},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rawTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseTransactionData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Compare(parsed.Message.Instructions[0].Data, memo) != 0 {
+		t.Fatal("Unexpected instruction data")
+	}
+}
+
+func FuzzShortVec(f *testing.F) {
+	for _, seed := range [][]byte{{0x00}, {0x7f}, {0x80, 0x01}, {0xff, 0xff, 0x03}, {0x80, 0x00}} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n, remaining, err := readShortVecLength(data)
+		if err != nil {
+			return
+		}
+		encoded, err := appendShortVecLength(nil, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		//Only canonical encodings are accepted, so decoding consumed exactly the encoding
+		if slices.Compare(encoded, data[:len(data)-len(remaining)]) != 0 {
+			t.Fatal("Round trip mismatch", data, encoded)
+		}
+	})
+}
+
+func FuzzParseTransactionData(f *testing.F) {
+	payer := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	loaded := MustParsePubkey("2u83Dx5qPV4QnujjJQv8v2SoqG1ixuAxPK5Jwhtkovd1")
+	tx := Transaction{
+		Signatures: []string{"5WUMzKkDaSuLUj3RpbufHi2PLRPgkjkHhsJpLE7Q3a3fMNDkV579zDmWLfMTnw4my5cbHKicRhYDTQsoAidv8nYD"},
+		Message: Message{
+			Instructions:    []Instruction{SystemProgramInstructions().Transfer(payer, loaded, 1)},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	for _, tables := range [][]AddressLookupTable{nil, {{Key: VoteProgram, Addresses: []Pubkey{loaded}}}} {
+		rawTx, err := tx.Serialize(tables...)
+		if err != nil {
+			f.Fatal(err)
+		}
+		data, err := rawTx.Bytes()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		rawTx, err := ParseTransactionData(data)
+		if err != nil {
+			return
+		}
+		encoded, err := rawTx.Bytes()
+		if err != nil {
+			t.Fatal("Parsed transaction does not encode", err)
+		}
+		//Trailing data is refused, so whatever parses must round trip exactly
+		if slices.Compare(encoded, data) != 0 {
+			t.Fatal("Round trip mismatch")
+		}
+		reparsed, err := ParseTransactionData(encoded)
+		if err != nil {
+			t.Fatal("Encoded transaction does not parse", err)
+		}
+		reencoded, err := reparsed.Bytes()
+		if err != nil || slices.Compare(encoded, reencoded) != 0 {
+			t.Fatal("Encoding is not stable", err)
+		}
+		//Checking untrusted transactions must not panic
+		rawTx.Verify()
+	})
+}
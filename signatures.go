@@ -0,0 +1,147 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"slices"
+
+	"github.com/mr-tron/base58"
+)
+
+const signatureLength = ed25519.SignatureSize
+
+// Errors signing a transaction. Use them with errors.Is.
+var (
+	ErrSignerNotRequired       = NewSolanaError(SOLANA_ERROR__TRANSACTION__ADDRESSES_CANNOT_SIGN_TRANSACTION, "key is not a required signer of the transaction")
+	ErrInvalidSignatureLength  = NewSolanaError(SOLANA_ERROR__KEYS__INVALID_SIGNATURE_BYTE_LENGTH, "signature must be 64 bytes")
+	ErrMessageSignerMismatched = NewSolanaError(SOLANA_ERROR__TRANSACTION__MESSAGE_SIGNATURES_MISMATCH, "signatures do not match the required signers of the message")
+)
+
+// Signs the message and puts each signature in the slot of its signer. Signatures already in other slots are kept, so several parties can each add theirs.
+// Signers that are also a Pubkey, like Keypair and Client, are matched to their slot by key. Other signers are matched by verifying their signature against the required signers.
+// Signing with a key that is not a required signer fails with ErrSignerNotRequired.
+func (rawTx *RawTransaction) Sign(signers ...Signer) error {
+	data, err := rawTx.Message.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := rawTx.resizeSignatures(); err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		slot := -1
+		if pubkey, ok := signer.(Pubkey); ok {
+			if slot = rawTx.signerSlot(pubkey); slot < 0 {
+				return errorWithContext(ErrSignerNotRequired, map[string]any{"address": pubkey.String()})
+			}
+		}
+		signature, err := signer.Sign(data)
+		if err != nil {
+			return err
+		}
+		if len(signature) != signatureLength {
+			return ErrInvalidSignatureLength
+		}
+		if slot < 0 {
+			slot = slices.IndexFunc(rawTx.Message.AccountKeys[:len(rawTx.Signatures)], func(key Pubkey) bool {
+				return ed25519.Verify(key.Bytes(), data, signature)
+			})
+			if slot < 0 {
+				return ErrSignerNotRequired
+			}
+		}
+		rawTx.Signatures[slot] = base58.Encode(signature)
+	}
+	return nil
+}
+
+// Puts a signature made by an external signer, such as a hardware wallet or another service, in the slot of pubkey.
+func (rawTx *RawTransaction) AddSignature(pubkey Pubkey, signature []byte) error {
+	if len(signature) != signatureLength {
+		return ErrInvalidSignatureLength
+	}
+	if err := rawTx.resizeSignatures(); err != nil {
+		return err
+	}
+	slot := rawTx.signerSlot(pubkey)
+	if slot < 0 {
+		return errorWithContext(ErrSignerNotRequired, map[string]any{"address": pubkey.String()})
+	}
+	rawTx.Signatures[slot] = base58.Encode(signature)
+	return nil
+}
+
+// Returns the required signers whose signature is still missing, in the order of the account keys.
+func (rawTx RawTransaction) MissingSigners() []Pubkey {
+	var missing []Pubkey
+	for i := 0; i < rawTx.Message.Header.NumRequiredSignatures && i < len(rawTx.Message.AccountKeys); i++ {
+		if i >= len(rawTx.Signatures) || rawTx.Signatures[i] == "" {
+			missing = append(missing, rawTx.Message.AccountKeys[i])
+		}
+	}
+	return missing
+}
+
+// Returns the slot of pubkey's signature, -1 if it is not a required signer.
+func (rawTx RawTransaction) signerSlot(pubkey Pubkey) int {
+	return slices.IndexFunc(rawTx.Message.AccountKeys[:len(rawTx.Signatures)], func(key Pubkey) bool {
+		return key.String() == pubkey.String()
+	})
+}
+
+// Makes room for one signature per required signer. A transaction can only hold more signatures than its message requires if they are all empty.
+func (rawTx *RawTransaction) resizeSignatures() error {
+	required := rawTx.Message.Header.NumRequiredSignatures
+	if required > len(rawTx.Message.AccountKeys) {
+		return ErrMessageSignerMismatched
+	}
+	if len(rawTx.Signatures) > required {
+		if slices.ContainsFunc(rawTx.Signatures[required:], func(signature string) bool { return signature != "" }) {
+			return ErrMessageSignerMismatched
+		}
+		rawTx.Signatures = rawTx.Signatures[:required]
+	}
+	for len(rawTx.Signatures) < required {
+		rawTx.Signatures = append(rawTx.Signatures, "")
+	}
+	return nil
+}
+
+// Signs the compiled transaction, keeping the signatures already added by other signers. See RawTransaction.Sign.
+func (tx *Transaction) Sign(signers ...Signer) error {
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		return err
+	}
+	if err := rawTx.Sign(signers...); err != nil {
+		return err
+	}
+	tx.Signatures = rawTx.Signatures
+	return nil
+}
+
+// Puts a signature made by an external signer in the slot of pubkey. See RawTransaction.AddSignature.
+func (tx *Transaction) AddSignature(pubkey Pubkey, signature []byte) error {
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		return err
+	}
+	if err := rawTx.AddSignature(pubkey, signature); err != nil {
+		return err
+	}
+	tx.Signatures = rawTx.Signatures
+	return nil
+}
+
+// Returns the required signers whose signature is still missing, fee payer first.
+func (tx Transaction) MissingSigners() ([]Pubkey, error) {
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return rawTx.MissingSigners(), nil
+}
+
+// Returns whether the signature is all zeros, which is how the wire format fills the slots of missing signatures.
+func isEmptySignature(signature []byte) bool {
+	return !slices.ContainsFunc(signature, func(b byte) bool { return b != 0 })
+}
@@ -0,0 +1,140 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/mr-tron/base58"
+)
+
+// Returns a keypair generated from seed, so tests are deterministic.
+func newTestKeypair(t *testing.T, seed byte) Keypair {
+	keypair, err := NewKeypair(ed25519.NewKeyFromSeed(slices.Repeat([]byte{seed}, ed25519.SeedSize)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keypair
+}
+
+func TestPartialSigning(t *testing.T) {
+	payer := newTestKeypair(t, 1)
+	cosigner := newTestKeypair(t, 2)
+	external := newTestKeypair(t, 3)
+	tx := Transaction{
+		Message: Message{
+			FeePayer: payer.Pubkey,
+			Instructions: []Instruction{{
+				Accounts: []AccountMeta{
+					{Pubkey: cosigner.Pubkey, Signer: true},
+					{Pubkey: external.Pubkey, Signer: true, Writable: true},
+				},
+				ProgramID: MustParsePubkey("MemoSq4gqABAXKb96qnH8TysNcWxMyWCqXgDLGmfcHr"),
+			}},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing := rawTx.MissingSigners(); len(missing) != 3 {
+		t.Fatal("Expected every signer to be missing, got", missing)
+	}
+
+	//Signing out of order still puts each signature in its signer's slot
+	if err := tx.Sign(cosigner); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Sign(payer); err != nil {
+		t.Fatal(err)
+	}
+	signatures := slices.Clone(tx.Signatures)
+	if err := tx.Sign(payer); err != nil {
+		t.Fatal(err)
+	}
+	if slices.Compare(signatures, tx.Signatures) != 0 {
+		t.Fatal("Expected signing twice to give the same signatures", signatures, tx.Signatures)
+	}
+	missing, err := tx.MissingSigners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 1 || missing[0].String() != external.Pubkey.String() {
+		t.Fatal("Unexpected missing signers", missing)
+	}
+
+	//An unsigned slot survives the wire format
+	rawTx, err = tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rawTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseTransactionData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Compare(parsed.Signatures, tx.Signatures) != 0 {
+		t.Fatal("Unexpected parsed signatures", parsed.Signatures)
+	}
+
+	message, err := parsed.Message.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := external.Sign(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := parsed.AddSignature(external.Pubkey, signature); err != nil {
+		t.Fatal(err)
+	}
+	if missing := parsed.MissingSigners(); len(missing) != 0 {
+		t.Fatal("Expected no missing signers, got", missing)
+	}
+	for i, key := range parsed.Message.AccountKeys[:3] {
+		signature, err := base58.Decode(parsed.Signatures[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ed25519.Verify(key.Bytes(), message, signature) {
+			t.Fatal("Signature in the wrong slot", i)
+		}
+	}
+}
+
+func TestSignWithoutPubkey(t *testing.T) {
+	keypair := newTestKeypair(t, 1)
+	tx := Transaction{
+		Message: Message{
+			FeePayer:        keypair.Pubkey,
+			Instructions:    []Instruction{SystemProgramInstructions().Transfer(keypair.Pubkey, newTestKeypair(t, 2).Pubkey, 1)},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	//A bare signer is matched to its slot by its signature
+	if err := tx.Sign(keypair.Signer); err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Signatures) != 1 || tx.Signatures[0] == "" {
+		t.Fatal("Unexpected signatures", tx.Signatures)
+	}
+
+	stranger := newTestKeypair(t, 3)
+	if err := tx.Sign(stranger); !errors.Is(err, ErrSignerNotRequired) {
+		t.Fatal("Expected signing with a key that is not required to fail, got", err)
+	}
+	if err := tx.Sign(stranger.Signer); !errors.Is(err, ErrSignerNotRequired) {
+		t.Fatal("Expected signing with a key that is not required to fail, got", err)
+	}
+	if err := tx.AddSignature(stranger.Pubkey, make([]byte, 64)); !errors.Is(err, ErrSignerNotRequired) {
+		t.Fatal("Expected adding a signature of a key that is not required to fail, got", err)
+	}
+	if err := tx.AddSignature(keypair.Pubkey, make([]byte, 63)); !errors.Is(err, ErrInvalidSignatureLength) {
+		t.Fatal("Expected adding a short signature to fail, got", err)
+	}
+}
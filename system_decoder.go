@@ -0,0 +1,250 @@
+package solana
+
+import (
+	"encoding/binary"
+)
+
+// Errors decoding an instruction. Use them with errors.Is.
+var (
+	ErrInstructionProgramMismatch = NewSolanaError(SOLANA_ERROR__INSTRUCTION__PROGRAM_ID_MISMATCH, "instruction does not belong to the decoded program")
+	ErrInstructionMissingAccounts = NewSolanaError(SOLANA_ERROR__INSTRUCTION__EXPECTED_TO_HAVE_ACCOUNTS, "instruction has fewer accounts than its variant requires")
+	ErrInstructionDataTooShort    = NewSolanaError(SOLANA_ERROR__CODECS__INVALID_BYTE_LENGTH, "instruction data is too short for its variant")
+	ErrUnknownInstruction         = NewSolanaError(SOLANA_ERROR__CODECS__ENUM_DISCRIMINATOR_OUT_OF_RANGE, "instruction discriminant is not a known variant")
+)
+
+// A decoded System Program instruction, one of the System* instruction types. Use a type switch to tell them apart.
+type SystemInstruction interface {
+	systemInstruction()
+}
+
+type SystemCreateAccount struct {
+	From       Pubkey //Account funding the new account
+	NewAccount Pubkey //Account created
+	Lamports   uint   //Lamports transferred to the new account
+	Space      uint   //Bytes of data allocated to the new account
+	Owner      Pubkey //Program owning the new account
+}
+
+type SystemAssign struct {
+	Account Pubkey //Account assigned
+	Owner   Pubkey //Program the account is assigned to
+}
+
+type SystemTransfer struct {
+	Source      Pubkey //Account the lamports are taken from
+	Destination Pubkey //Account receiving the lamports
+	Lamports    uint   //Lamports transferred
+}
+
+type SystemCreateAccountWithSeed struct {
+	From       Pubkey //Account funding the new account
+	NewAccount Pubkey //Account created, derived from Base, Seed and Owner
+	Base       Pubkey //Account the new account's address is derived from
+	Seed       string //Seed the new account's address is derived from
+	Lamports   uint   //Lamports transferred to the new account
+	Space      uint   //Bytes of data allocated to the new account
+	Owner      Pubkey //Program owning the new account
+}
+
+type SystemAdvanceNonceAccount struct {
+	NonceAccount Pubkey //Nonce account advanced
+	Authority    Pubkey //Authority of the nonce account
+}
+
+type SystemWithdrawNonceAccount struct {
+	NonceAccount Pubkey //Nonce account the lamports are taken from
+	Destination  Pubkey //Account receiving the lamports
+	Authority    Pubkey //Authority of the nonce account
+	Lamports     uint   //Lamports withdrawn
+}
+
+type SystemInitializeNonceAccount struct {
+	NonceAccount Pubkey //Nonce account initialized
+	Authority    Pubkey //Authority given to the nonce account
+}
+
+type SystemAuthorizeNonceAccount struct {
+	NonceAccount Pubkey //Nonce account whose authority changes
+	Authority    Pubkey //Current authority of the nonce account
+	NewAuthority Pubkey //Authority the nonce account is handed over to
+}
+
+type SystemAllocate struct {
+	Account Pubkey //Account the data is allocated to
+	Space   uint   //Bytes of data allocated
+}
+
+type SystemAllocateWithSeed struct {
+	Account Pubkey //Account the data is allocated to, derived from Base, Seed and Owner
+	Base    Pubkey //Account the address is derived from
+	Seed    string //Seed the address is derived from
+	Space   uint   //Bytes of data allocated
+	Owner   Pubkey //Program the account is assigned to
+}
+
+type SystemAssignWithSeed struct {
+	Account Pubkey //Account assigned, derived from Base, Seed and Owner
+	Base    Pubkey //Account the address is derived from
+	Seed    string //Seed the address is derived from
+	Owner   Pubkey //Program the account is assigned to
+}
+
+type SystemTransferWithSeed struct {
+	Source      Pubkey //Account the lamports are taken from, derived from Base, SourceSeed and SourceOwner
+	Base        Pubkey //Account the source address is derived from
+	Destination Pubkey //Account receiving the lamports
+	Lamports    uint   //Lamports transferred
+	SourceSeed  string //Seed the source address is derived from
+	SourceOwner Pubkey //Program owning the source account
+}
+
+type SystemUpgradeNonceAccount struct {
+	NonceAccount Pubkey //Nonce account upgraded
+}
+
+func (SystemCreateAccount) systemInstruction()          {}
+func (SystemAssign) systemInstruction()                 {}
+func (SystemTransfer) systemInstruction()               {}
+func (SystemCreateAccountWithSeed) systemInstruction()  {}
+func (SystemAdvanceNonceAccount) systemInstruction()    {}
+func (SystemWithdrawNonceAccount) systemInstruction()   {}
+func (SystemInitializeNonceAccount) systemInstruction() {}
+func (SystemAuthorizeNonceAccount) systemInstruction()  {}
+func (SystemAllocate) systemInstruction()               {}
+func (SystemAllocateWithSeed) systemInstruction()       {}
+func (SystemAssignWithSeed) systemInstruction()         {}
+func (SystemTransferWithSeed) systemInstruction()       {}
+func (SystemUpgradeNonceAccount) systemInstruction()    {}
+
+// Accounts each System Program instruction requires, by discriminant.
+var systemInstructionAccounts = map[uint32]int{
+	systemCreateAccount:          2,
+	systemAssign:                 1,
+	systemTransfer:               2,
+	systemCreateAccountWithSeed:  2,
+	systemAdvanceNonceAccount:    3,
+	systemWithdrawNonceAccount:   5,
+	systemInitializeNonceAccount: 3,
+	systemAuthorizeNonceAccount:  2,
+	systemAllocate:               1,
+	systemAllocateWithSeed:       2,
+	systemAssignWithSeed:         2,
+	systemTransferWithSeed:       3,
+	systemUpgradeNonceAccount:    1,
+}
+
+// Decodes a System Program instruction, e.g. from the Transaction returned by RawTransaction.Transaction, into one of the System* instruction types.
+func DecodeSystemInstruction(instruction Instruction) (SystemInstruction, error) {
+	if instruction.ProgramID == nil || instruction.ProgramID.String() != SystemProgram.String() {
+		return nil, ErrInstructionProgramMismatch
+	}
+	data := systemInstructionData{data: instruction.Data}
+	kind := data.u32()
+	if data.err != nil {
+		return nil, data.err
+	}
+	required, ok := systemInstructionAccounts[kind]
+	if !ok {
+		return nil, errorWithContext(ErrUnknownInstruction, map[string]any{"discriminant": kind})
+	}
+	if len(instruction.Accounts) < required {
+		return nil, errorWithContext(ErrInstructionMissingAccounts, map[string]any{"expected": required, "actual": len(instruction.Accounts)})
+	}
+	account := func(i int) Pubkey {
+		return instruction.Accounts[i].Pubkey
+	}
+
+	//Fields are read in the order they are encoded
+	var decoded SystemInstruction
+	switch kind {
+	case systemCreateAccount:
+		decoded = SystemCreateAccount{From: account(0), NewAccount: account(1), Lamports: data.u64(), Space: data.u64(), Owner: data.pubkey()}
+	case systemAssign:
+		decoded = SystemAssign{Account: account(0), Owner: data.pubkey()}
+	case systemTransfer:
+		decoded = SystemTransfer{Source: account(0), Destination: account(1), Lamports: data.u64()}
+	case systemCreateAccountWithSeed:
+		decoded = SystemCreateAccountWithSeed{From: account(0), NewAccount: account(1), Base: data.pubkey(), Seed: data.string(), Lamports: data.u64(), Space: data.u64(), Owner: data.pubkey()}
+	case systemAdvanceNonceAccount:
+		decoded = SystemAdvanceNonceAccount{NonceAccount: account(0), Authority: account(2)}
+	case systemWithdrawNonceAccount:
+		decoded = SystemWithdrawNonceAccount{NonceAccount: account(0), Destination: account(1), Authority: account(4), Lamports: data.u64()}
+	case systemInitializeNonceAccount:
+		decoded = SystemInitializeNonceAccount{NonceAccount: account(0), Authority: data.pubkey()}
+	case systemAuthorizeNonceAccount:
+		decoded = SystemAuthorizeNonceAccount{NonceAccount: account(0), Authority: account(1), NewAuthority: data.pubkey()}
+	case systemAllocate:
+		decoded = SystemAllocate{Account: account(0), Space: data.u64()}
+	case systemAllocateWithSeed:
+		decoded = SystemAllocateWithSeed{Account: account(0), Base: data.pubkey(), Seed: data.string(), Space: data.u64(), Owner: data.pubkey()}
+	case systemAssignWithSeed:
+		decoded = SystemAssignWithSeed{Account: account(0), Base: data.pubkey(), Seed: data.string(), Owner: data.pubkey()}
+	case systemTransferWithSeed:
+		decoded = SystemTransferWithSeed{Source: account(0), Base: account(1), Destination: account(2), Lamports: data.u64(), SourceSeed: data.string(), SourceOwner: data.pubkey()}
+	case systemUpgradeNonceAccount:
+		decoded = SystemUpgradeNonceAccount{NonceAccount: account(0)}
+	}
+	if data.err != nil {
+		return nil, data.err
+	}
+	return decoded, nil
+}
+
+// Reads the bincode encoded fields of System Program instruction data. Reading past the end of the data sets err, and later reads return zero values.
+type systemInstructionData struct {
+	data []byte
+	err  error
+}
+
+func (d *systemInstructionData) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.data) < n {
+		d.err = ErrInstructionDataTooShort
+		return nil
+	}
+	field := d.data[:n]
+	d.data = d.data[n:]
+	return field
+}
+
+func (d *systemInstructionData) u32() uint32 {
+	if field := d.next(4); field != nil {
+		return binary.LittleEndian.Uint32(field)
+	}
+	return 0
+}
+
+func (d *systemInstructionData) u64() uint {
+	if field := d.next(8); field != nil {
+		return uint(binary.LittleEndian.Uint64(field))
+	}
+	return 0
+}
+
+func (d *systemInstructionData) pubkey() Pubkey {
+	field := d.next(32)
+	if field == nil {
+		return nil
+	}
+	pubkey, err := ParsePubkeyBytes(field)
+	if err != nil {
+		d.err = err
+	}
+	return pubkey
+}
+
+// Reads a string prefixed by its length as a u64.
+func (d *systemInstructionData) string() string {
+	field := d.next(8)
+	if field == nil {
+		return ""
+	}
+	length := binary.LittleEndian.Uint64(field)
+	if length > uint64(len(d.data)) {
+		d.err = errorWithContext(ErrInstructionDataTooShort, map[string]any{"stringLength": length})
+		return ""
+	}
+	return string(d.next(int(length)))
+}
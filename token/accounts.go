@@ -0,0 +1,164 @@
+package token
+
+import (
+	"encoding/binary"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Sizes of the accounts of the Token Program, to create them with.
+const (
+	MintSize     = 82
+	AccountSize  = 165
+	MultisigSize = 355
+
+	MaxSigners = 11 //Most signers a Multisig can have
+)
+
+// Errors decoding token accounts. Use them with errors.Is.
+var (
+	ErrInvalidAccountSize    = solana.NewSolanaError(solana.SOLANA_ERROR__ACCOUNTS__FAILED_TO_DECODE_ACCOUNT, "account data does not have the size of the decoded account type")
+	ErrAccountNotInitialized = solana.NewSolanaError(solana.SOLANA_ERROR__INSTRUCTION_ERROR__UNINITIALIZED_ACCOUNT, "account is not initialized")
+)
+
+type Mint struct {
+	MintAuthority   solana.Pubkey //Account allowed to mint new tokens, nil once the supply is fixed
+	Supply          uint64        //Tokens in circulation, in base units
+	Decimals        uint8         //Decimal places of the token's UI amount
+	FreezeAuthority solana.Pubkey //Account allowed to freeze token accounts, nil if they cannot be frozen
+	Extensions      []Extension   //Extensions of a Token-2022 mint. Use GetExtension to find one.
+}
+
+// State of a token account.
+type AccountState uint8
+
+const (
+	AccountStateUninitialized AccountState = iota
+	AccountStateInitialized
+	AccountStateFrozen //The account's tokens cannot be moved until its mint's freeze authority thaws it
+)
+
+type TokenAccount struct {
+	Mint             solana.Pubkey //Mint of the tokens held
+	Owner            solana.Pubkey //Account allowed to transfer, burn and delegate the tokens
+	Amount           uint64        //Tokens held, in base units
+	Delegate         solana.Pubkey //Account allowed to transfer and burn up to DelegatedAmount tokens, nil if none
+	State            AccountState
+	IsNative         bool          //Whether the account holds wrapped SOL, whose amount is the lamports above NativeRentExempt
+	NativeRentExempt uint64        //Lamports kept for the rent exemption of a wrapped SOL account
+	DelegatedAmount  uint64        //Tokens the delegate may still transfer or burn
+	CloseAuthority   solana.Pubkey //Account allowed to close the account, the owner if nil
+	Extensions       []Extension   //Extensions of a Token-2022 token account. Use GetExtension to find one.
+}
+
+type Multisig struct {
+	M       uint8           //Signatures required
+	Signers []solana.Pubkey //Accounts allowed to sign, at most MaxSigners
+}
+
+// Decodes the data of a mint account of the Token Program or Token-2022, along with its extensions.
+func ParseMint(data []byte) (Mint, error) {
+	if len(data) != MintSize && !hasAccountType(data, accountTypeMint) {
+		return Mint{}, ErrInvalidAccountSize
+	}
+	//Byte 45 is whether the mint is initialized
+	if data[45] == 0 {
+		return Mint{}, ErrAccountNotInitialized
+	}
+	mintAuthority, err := readPubkeyOption(data[0:36])
+	if err != nil {
+		return Mint{}, err
+	}
+	freezeAuthority, err := readPubkeyOption(data[46:82])
+	if err != nil {
+		return Mint{}, err
+	}
+	extensions, err := ParseExtensions(data)
+	if err != nil {
+		return Mint{}, err
+	}
+	return Mint{
+		MintAuthority:   mintAuthority,
+		Supply:          binary.LittleEndian.Uint64(data[36:44]),
+		Decimals:        data[44],
+		FreezeAuthority: freezeAuthority,
+		Extensions:      extensions,
+	}, nil
+}
+
+// Decodes the data of a token account of the Token Program or Token-2022, along with its extensions.
+func ParseTokenAccount(data []byte) (TokenAccount, error) {
+	if len(data) != AccountSize && !hasAccountType(data, accountTypeAccount) {
+		return TokenAccount{}, ErrInvalidAccountSize
+	}
+	state := AccountState(data[108])
+	if state == AccountStateUninitialized {
+		return TokenAccount{}, ErrAccountNotInitialized
+	}
+	mint, err := solana.ParsePubkeyBytes(data[0:32])
+	if err != nil {
+		return TokenAccount{}, err
+	}
+	owner, err := solana.ParsePubkeyBytes(data[32:64])
+	if err != nil {
+		return TokenAccount{}, err
+	}
+	delegate, err := readPubkeyOption(data[72:108])
+	if err != nil {
+		return TokenAccount{}, err
+	}
+	closeAuthority, err := readPubkeyOption(data[129:165])
+	if err != nil {
+		return TokenAccount{}, err
+	}
+	extensions, err := ParseExtensions(data)
+	if err != nil {
+		return TokenAccount{}, err
+	}
+	return TokenAccount{
+		Mint:             mint,
+		Owner:            owner,
+		Amount:           binary.LittleEndian.Uint64(data[64:72]),
+		Delegate:         delegate,
+		State:            state,
+		IsNative:         binary.LittleEndian.Uint32(data[109:113]) == 1,
+		NativeRentExempt: binary.LittleEndian.Uint64(data[113:121]),
+		DelegatedAmount:  binary.LittleEndian.Uint64(data[121:129]),
+		CloseAuthority:   closeAuthority,
+		Extensions:       extensions,
+	}, nil
+}
+
+// Decodes the data of a multisig account.
+func ParseMultisig(data []byte) (Multisig, error) {
+	if len(data) != MultisigSize {
+		return Multisig{}, ErrInvalidAccountSize
+	}
+	//Data starts with m, n and whether the multisig is initialized
+	if data[2] == 0 {
+		return Multisig{}, ErrAccountNotInitialized
+	}
+	n := min(int(data[1]), MaxSigners)
+	signers := make([]solana.Pubkey, n)
+	for i := range signers {
+		signer, err := solana.ParsePubkeyBytes(data[3+32*i : 35+32*i])
+		if err != nil {
+			return Multisig{}, err
+		}
+		signers[i] = signer
+	}
+	return Multisig{M: data[0], Signers: signers}, nil
+}
+
+// Returns whether the data is a Token-2022 account with extensions of the account type. A multisig is never mistaken for one, as such accounts are padded so they never have its size.
+func hasAccountType(data []byte, accountType byte) bool {
+	return len(data) > AccountSize && len(data) != MultisigSize && data[AccountSize] == accountType
+}
+
+// Reads an optional pubkey the way the Token Program stores it in accounts: a 4 byte tag, followed by the pubkey, zeroed if the tag is 0.
+func readPubkeyOption(data []byte) (solana.Pubkey, error) {
+	if binary.LittleEndian.Uint32(data[:4]) == 0 {
+		return nil, nil
+	}
+	return solana.ParsePubkeyBytes(data[4:36])
+}
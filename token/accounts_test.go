@@ -0,0 +1,88 @@
+package token
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+func TestParseMint(t *testing.T) {
+	data := make([]byte, MintSize)
+	binary.LittleEndian.PutUint32(data[0:4], 1)
+	copy(data[4:36], testOwner.Bytes())
+	binary.LittleEndian.PutUint64(data[36:44], 1_000_000)
+	data[44] = 6
+	data[45] = 1
+
+	mint, err := ParseMint(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mint.MintAuthority.String() != testOwner.String() || mint.Supply != 1_000_000 || mint.Decimals != 6 || mint.FreezeAuthority != nil {
+		t.Fatal("Unexpected mint", mint)
+	}
+
+	data[45] = 0
+	if _, err := ParseMint(data); !errors.Is(err, ErrAccountNotInitialized) {
+		t.Fatal("Expected the mint not to be initialized, got", err)
+	}
+	if _, err := ParseMint(make([]byte, AccountSize)); !errors.Is(err, ErrInvalidAccountSize) {
+		t.Fatal("Expected a token account not to decode as a mint, got", err)
+	}
+}
+
+func TestParseTokenAccount(t *testing.T) {
+	data := make([]byte, AccountSize)
+	copy(data[0:32], testMint.Bytes())
+	copy(data[32:64], testOwner.Bytes())
+	binary.LittleEndian.PutUint64(data[64:72], 42)
+	binary.LittleEndian.PutUint32(data[72:76], 1)
+	copy(data[76:108], testRecipient.Bytes())
+	data[108] = byte(AccountStateFrozen)
+	binary.LittleEndian.PutUint32(data[109:113], 1)
+	binary.LittleEndian.PutUint64(data[113:121], 2_039_280)
+	binary.LittleEndian.PutUint64(data[121:129], 7)
+
+	account, err := ParseTokenAccount(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := TokenAccount{
+		Mint:             testMint,
+		Owner:            testOwner,
+		Amount:           42,
+		Delegate:         testRecipient,
+		State:            AccountStateFrozen,
+		IsNative:         true,
+		NativeRentExempt: 2_039_280,
+		DelegatedAmount:  7,
+	}
+	if account.Mint.String() != expected.Mint.String() || account.Owner.String() != expected.Owner.String() || account.Delegate.String() != expected.Delegate.String() ||
+		account.Amount != expected.Amount || account.State != expected.State || !account.IsNative || account.NativeRentExempt != expected.NativeRentExempt ||
+		account.DelegatedAmount != expected.DelegatedAmount || account.CloseAuthority != nil {
+		t.Fatal("Expected", expected, "got", account)
+	}
+
+	data[108] = byte(AccountStateUninitialized)
+	if _, err := ParseTokenAccount(data); !errors.Is(err, ErrAccountNotInitialized) {
+		t.Fatal("Expected the account not to be initialized, got", err)
+	}
+}
+
+func TestParseMultisig(t *testing.T) {
+	data := make([]byte, MultisigSize)
+	data[0], data[1], data[2] = 2, 3, 1
+	for i, signer := range []solana.Pubkey{testOwner, testSource, testRecipient} {
+		copy(data[3+32*i:], signer.Bytes())
+	}
+
+	multisig, err := ParseMultisig(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if multisig.M != 2 || len(multisig.Signers) != 3 || multisig.Signers[2].String() != testRecipient.String() {
+		t.Fatal("Unexpected multisig", multisig)
+	}
+}
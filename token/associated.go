@@ -0,0 +1,112 @@
+package token
+
+import (
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+// Instruction discriminants of the Associated Token Account Program, encoded as a u8.
+const (
+	associatedCreate           uint8 = 0
+	associatedCreateIdempotent uint8 = 1
+	associatedRecoverNested    uint8 = 2
+)
+
+// Returns the address of the associated token account of wallet for mint, the token account wallets and programs expect to receive mint's tokens at.
+// tokenProgram is the program owning mint: solana.TokenProgram or solana.Token2022Program.
+func AssociatedTokenAddress(wallet solana.Pubkey, mint solana.Pubkey, tokenProgram solana.Pubkey) (solana.Pubkey, error) {
+	address, _, err := solana.FindProgramAddress([][]byte{wallet.Bytes(), tokenProgram.Bytes(), mint.Bytes()}, solana.AssociatedTokenProgram)
+	return address, err
+}
+
+// Instructions of the Associated Token Account Program for the mints of a token program.
+type AssociatedTokenIxs interface {
+	Create(payer solana.Pubkey, wallet solana.Pubkey, mint solana.Pubkey) (solana.Instruction, error)                  //Creates the associated token account of wallet for mint, payer funding its rent exemption. Fails if it already exists.
+	CreateIdempotent(payer solana.Pubkey, wallet solana.Pubkey, mint solana.Pubkey) (solana.Instruction, error)        //Like Create, but succeeds without doing anything if the account already exists
+	RecoverNested(wallet solana.Pubkey, ownerMint solana.Pubkey, nestedMint solana.Pubkey) (solana.Instruction, error) //Moves the nestedMint tokens sent to the associated token account of wallet's ownerMint account into wallet's own nestedMint account, closing the nested account
+}
+
+type associatedTokenIxs struct {
+	tokenProgram solana.Pubkey
+}
+
+// Returns the instructions of the Associated Token Account Program for the mints of tokenProgram: solana.TokenProgram or solana.Token2022Program.
+func AssociatedTokenInstructions(tokenProgram solana.Pubkey) AssociatedTokenIxs {
+	return &associatedTokenIxs{tokenProgram: tokenProgram}
+}
+
+func (a associatedTokenIxs) Create(payer solana.Pubkey, wallet solana.Pubkey, mint solana.Pubkey) (solana.Instruction, error) {
+	return a.create(associatedCreate, payer, wallet, mint)
+}
+
+func (a associatedTokenIxs) CreateIdempotent(payer solana.Pubkey, wallet solana.Pubkey, mint solana.Pubkey) (solana.Instruction, error) {
+	return a.create(associatedCreateIdempotent, payer, wallet, mint)
+}
+
+func (a associatedTokenIxs) create(kind uint8, payer solana.Pubkey, wallet solana.Pubkey, mint solana.Pubkey) (solana.Instruction, error) {
+	account, err := AssociatedTokenAddress(wallet, mint, a.tokenProgram)
+	if err != nil {
+		return solana.Instruction{}, err
+	}
+	return solana.Instruction{
+		ProgramID: solana.AssociatedTokenProgram,
+		Data:      []byte{kind},
+		Accounts: []solana.AccountMeta{
+			{Pubkey: payer, Signer: true, Writable: true},
+			{Pubkey: account, Writable: true},
+			{Pubkey: wallet},
+			{Pubkey: mint},
+			{Pubkey: solana.SystemProgram},
+			{Pubkey: a.tokenProgram},
+		},
+	}, nil
+}
+
+func (a associatedTokenIxs) RecoverNested(wallet solana.Pubkey, ownerMint solana.Pubkey, nestedMint solana.Pubkey) (solana.Instruction, error) {
+	owner, err := AssociatedTokenAddress(wallet, ownerMint, a.tokenProgram)
+	if err != nil {
+		return solana.Instruction{}, err
+	}
+	//The nested account is the associated token account of the owner account itself
+	nested, err := AssociatedTokenAddress(owner, nestedMint, a.tokenProgram)
+	if err != nil {
+		return solana.Instruction{}, err
+	}
+	destination, err := AssociatedTokenAddress(wallet, nestedMint, a.tokenProgram)
+	if err != nil {
+		return solana.Instruction{}, err
+	}
+	return solana.Instruction{
+		ProgramID: solana.AssociatedTokenProgram,
+		Data:      []byte{associatedRecoverNested},
+		Accounts: []solana.AccountMeta{
+			{Pubkey: nested, Writable: true},
+			{Pubkey: nestedMint},
+			{Pubkey: destination, Writable: true},
+			{Pubkey: owner},
+			{Pubkey: ownerMint},
+			{Pubkey: wallet, Signer: true, Writable: true},
+			{Pubkey: a.tokenProgram},
+		},
+	}, nil
+}
+
+// Returns the instruction creating the associated token account of wallet for mint, or nil if the client finds it already exists.
+// The instruction is idempotent, so it still succeeds if the account is created before it lands.
+func EnsureAssociatedTokenAccount(client solana.Client, payer solana.Pubkey, wallet solana.Pubkey, mint solana.Pubkey, tokenProgram solana.Pubkey) (*solana.Instruction, error) {
+	address, err := AssociatedTokenAddress(wallet, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.GetAccountInfo(address)
+	if err != nil {
+		return nil, err
+	}
+	if account != nil {
+		return nil, nil
+	}
+	instruction, err := AssociatedTokenInstructions(tokenProgram).CreateIdempotent(payer, wallet, mint)
+	if err != nil {
+		return nil, err
+	}
+	return &instruction, nil
+}
@@ -0,0 +1,97 @@
+package token
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+func TestAssociatedTokenAddress(t *testing.T) {
+	wallet := solana.MustParsePubkey("B8UwBUUnKwCyKuGMbFKWaG7exYdDk2ozZrPg72NyVbfj")
+	mint := solana.MustParsePubkey("7o36UsWR1JQLpZ9PE2gn9L4SQ69CNNiWAXd4Jt7rqz9Z")
+	tests := []struct {
+		name         string
+		tokenProgram solana.Pubkey
+		expected     string
+	}{
+		{"Token Program", solana.TokenProgram, "DShWnroshVbeUp28oopA3Pu7oFPDBtC1DBmPECXXAQ9n"}, //From the tests of the spl-token JavaScript library
+		{"Token-2022", solana.Token2022Program, "6WD1d4QUPGyZ9pnwNqN1W6fsBd9zoJwZkDg9s7bYxVmq"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			address, err := AssociatedTokenAddress(wallet, mint, test.tokenProgram)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address.String() != test.expected {
+				t.Fatal("Expected", test.expected, "got", address)
+			}
+		})
+	}
+}
+
+func TestAssociatedTokenInstructions(t *testing.T) {
+	ixs := AssociatedTokenInstructions(solana.Token2022Program)
+	address, _ := AssociatedTokenAddress(testOwner, testMint, solana.Token2022Program)
+
+	create, err := ixs.Create(testSource, testOwner, testMint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idempotent, err := ixs.CreateIdempotent(testSource, testOwner, testMint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Compare(create.Data, []byte{0}) != 0 || slices.Compare(idempotent.Data, []byte{1}) != 0 {
+		t.Fatal("Unexpected data", create.Data, idempotent.Data)
+	}
+	if fmt.Sprint(create.Accounts) != fmt.Sprint(idempotent.Accounts) || create.ProgramID.String() != solana.AssociatedTokenProgram.String() {
+		t.Fatal("Expected both creations to take the same accounts", create.Accounts, idempotent.Accounts)
+	}
+	if len(create.Accounts) != 6 || !create.Accounts[0].Signer || create.Accounts[1].Pubkey.String() != address.String() || create.Accounts[5].Pubkey.String() != solana.Token2022Program.String() {
+		t.Fatal("Unexpected accounts", create.Accounts)
+	}
+
+	recover, err := ixs.RecoverNested(testOwner, testMint, testRecipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested, _ := AssociatedTokenAddress(address, testRecipient, solana.Token2022Program)
+	destination, _ := AssociatedTokenAddress(testOwner, testRecipient, solana.Token2022Program)
+	if slices.Compare(recover.Data, []byte{2}) != 0 || len(recover.Accounts) != 7 {
+		t.Fatal("Unexpected instruction", recover)
+	}
+	if recover.Accounts[0].Pubkey.String() != nested.String() || recover.Accounts[2].Pubkey.String() != destination.String() || recover.Accounts[3].Pubkey.String() != address.String() || !recover.Accounts[5].Signer {
+		t.Fatal("Unexpected accounts", recover.Accounts)
+	}
+}
+
+type associatedTestClient struct {
+	solana.Client
+	accounts map[string]*solana.Account
+}
+
+func (c associatedTestClient) GetAccountInfo(pubkey solana.Pubkey) (*solana.Account, error) {
+	return c.accounts[pubkey.String()], nil
+}
+
+func TestEnsureAssociatedTokenAccount(t *testing.T) {
+	address, _ := AssociatedTokenAddress(testOwner, testMint, solana.TokenProgram)
+	client := associatedTestClient{accounts: map[string]*solana.Account{}}
+
+	instruction, err := EnsureAssociatedTokenAccount(client, testSource, testOwner, testMint, solana.TokenProgram)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instruction == nil || slices.Compare(instruction.Data, []byte{1}) != 0 || instruction.Accounts[1].Pubkey.String() != address.String() {
+		t.Fatal("Expected an idempotent creation of the missing account, got", instruction)
+	}
+
+	client.accounts[address.String()] = &solana.Account{Owner: solana.TokenProgram}
+	instruction, err = EnsureAssociatedTokenAccount(client, testSource, testOwner, testMint, solana.TokenProgram)
+	if err != nil || instruction != nil {
+		t.Fatal("Expected no instruction for an existing account, got", instruction, err)
+	}
+}
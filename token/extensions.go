@@ -0,0 +1,333 @@
+package token
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/hwsimmons17/solana-web3.go"
+	"github.com/near/borsh-go"
+)
+
+// Type of a Token-2022 extension, stored before each extension in the extension area of mints and token accounts.
+type ExtensionType uint16
+
+const (
+	ExtensionUninitialized                 ExtensionType = iota
+	ExtensionTransferFeeConfig                           //Mint: fee charged on transfers
+	ExtensionTransferFeeAmount                           //Token account: fees withheld from transfers to it
+	ExtensionMintCloseAuthority                          //Mint: authority allowed to close it
+	ExtensionConfidentialTransferMint                    //Mint: confidential transfer configuration
+	ExtensionConfidentialTransferAccount                 //Token account: confidential balances
+	ExtensionDefaultAccountState                         //Mint: state new token accounts start in
+	ExtensionImmutableOwner                              //Token account: its owner cannot change
+	ExtensionMemoTransfer                                //Token account: incoming transfers require a memo
+	ExtensionNonTransferable                             //Mint: tokens cannot be transferred
+	ExtensionInterestBearingConfig                       //Mint: interest accrued by the UI amount
+	ExtensionCpiGuard                                    //Token account: restricts what programs can do with it through CPI
+	ExtensionPermanentDelegate                           //Mint: delegate of every token account of the mint
+	ExtensionNonTransferableAccount                      //Token account: holds non-transferable tokens
+	ExtensionTransferHook                                //Mint: program invoked on every transfer
+	ExtensionTransferHookAccount                         //Token account: transfer hook in progress
+	ExtensionConfidentialTransferFeeConfig               //Mint: confidential transfer fee configuration
+	ExtensionConfidentialTransferFeeAmount               //Token account: confidential withheld fees
+	ExtensionMetadataPointer                             //Mint: account holding the mint's metadata
+	ExtensionTokenMetadata                               //Mint: metadata stored in the mint itself
+)
+
+// Byte after the base account layout telling mints and token accounts with extensions apart.
+const (
+	accountTypeMint    = 1
+	accountTypeAccount = 2
+)
+
+// Highest transfer fee in basis points, the whole amount.
+const MaxFeeBasisPoints = 10_000
+
+// Errors decoding extensions. Use them with errors.Is.
+var (
+	ErrInvalidExtension = solana.NewSolanaError(solana.SOLANA_ERROR__ACCOUNTS__FAILED_TO_DECODE_ACCOUNT, "extension data does not match its type")
+)
+
+// A decoded Token-2022 extension, one of the extension types of this package. Extensions without a decoder are returned as UnknownExtension.
+type Extension interface {
+	Type() ExtensionType
+}
+
+// Fee charged on transfers from an epoch on.
+type TransferFee struct {
+	Epoch                  uint64 //First epoch the fee applies in
+	MaximumFee             uint64 //Highest fee charged on a transfer, in base units
+	TransferFeeBasisPoints uint16 //Fee in hundredths of a percent of the amount transferred
+}
+
+type TransferFeeConfig struct {
+	TransferFeeConfigAuthority solana.Pubkey //Account allowed to change the fee, nil if it cannot change
+	WithdrawWithheldAuthority  solana.Pubkey //Account allowed to withdraw withheld fees, nil if none
+	WithheldAmount             uint64        //Fees harvested to the mint and not withdrawn yet
+	OlderTransferFee           TransferFee   //Fee applying before NewerTransferFee's epoch
+	NewerTransferFee           TransferFee   //Fee applying from its epoch on
+}
+
+type TransferFeeAmount struct {
+	WithheldAmount uint64 //Fees withheld from transfers to the token account
+}
+
+type MintCloseAuthority struct {
+	CloseAuthority solana.Pubkey //Account allowed to close the mint once its supply is zero, nil if none
+}
+
+type DefaultAccountState struct {
+	State AccountState //State new token accounts of the mint start in
+}
+
+type ImmutableOwner struct{}
+
+type MemoTransfer struct {
+	RequireIncomingTransferMemos bool //Whether transfers to the token account must be preceded by a memo
+}
+
+type NonTransferable struct{}
+
+type InterestBearingConfig struct {
+	RateAuthority           solana.Pubkey //Account allowed to change the rate, nil if none
+	InitializationTimestamp int64         //Unix timestamp interest started accruing at
+	PreUpdateAverageRate    int16         //Average rate in basis points from initialization to the last update
+	LastUpdateTimestamp     int64         //Unix timestamp of the last rate update
+	CurrentRate             int16         //Rate in basis points since the last update
+}
+
+type CpiGuard struct {
+	LockCpi bool //Whether programs are restricted from using the token account through CPI
+}
+
+type PermanentDelegate struct {
+	Delegate solana.Pubkey //Account allowed to transfer and burn the tokens of every token account of the mint
+}
+
+type NonTransferableAccount struct{}
+
+type TransferHook struct {
+	Authority solana.Pubkey //Account allowed to change the program, nil if none
+	ProgramID solana.Pubkey //Program invoked on every transfer, nil if none
+}
+
+type TransferHookAccount struct {
+	Transferring bool //Whether a transfer of the token account's tokens is invoking the transfer hook
+}
+
+type MetadataPointer struct {
+	Authority       solana.Pubkey //Account allowed to change the pointer, nil if none
+	MetadataAddress solana.Pubkey //Account holding the mint's metadata, the mint itself for TokenMetadata, nil if none
+}
+
+type TokenMetadata struct {
+	UpdateAuthority    solana.Pubkey      //Account allowed to update the metadata, nil if it cannot change
+	Mint               solana.Pubkey      //Mint the metadata describes
+	Name               string             //Name of the token
+	Symbol             string             //Symbol of the token
+	Uri                string             //URI of the token's off-chain metadata
+	AdditionalMetadata []MetadataKeyValue //Further fields, in the order they were added
+}
+
+type MetadataKeyValue struct {
+	Key   string
+	Value string
+}
+
+// Extension this package does not decode, such as the confidential transfer extensions.
+type UnknownExtension struct {
+	ExtensionType ExtensionType
+	Data          []byte
+}
+
+func (TransferFeeConfig) Type() ExtensionType      { return ExtensionTransferFeeConfig }
+func (TransferFeeAmount) Type() ExtensionType      { return ExtensionTransferFeeAmount }
+func (MintCloseAuthority) Type() ExtensionType     { return ExtensionMintCloseAuthority }
+func (DefaultAccountState) Type() ExtensionType    { return ExtensionDefaultAccountState }
+func (ImmutableOwner) Type() ExtensionType         { return ExtensionImmutableOwner }
+func (MemoTransfer) Type() ExtensionType           { return ExtensionMemoTransfer }
+func (NonTransferable) Type() ExtensionType        { return ExtensionNonTransferable }
+func (InterestBearingConfig) Type() ExtensionType  { return ExtensionInterestBearingConfig }
+func (CpiGuard) Type() ExtensionType               { return ExtensionCpiGuard }
+func (PermanentDelegate) Type() ExtensionType      { return ExtensionPermanentDelegate }
+func (NonTransferableAccount) Type() ExtensionType { return ExtensionNonTransferableAccount }
+func (TransferHook) Type() ExtensionType           { return ExtensionTransferHook }
+func (TransferHookAccount) Type() ExtensionType    { return ExtensionTransferHookAccount }
+func (MetadataPointer) Type() ExtensionType        { return ExtensionMetadataPointer }
+func (TokenMetadata) Type() ExtensionType          { return ExtensionTokenMetadata }
+func (e UnknownExtension) Type() ExtensionType     { return e.ExtensionType }
+
+// Returns the extension of type T among the extensions of a mint or token account.
+func GetExtension[T Extension](extensions []Extension) (T, bool) {
+	for _, extension := range extensions {
+		if typed, ok := extension.(T); ok {
+			return typed, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Returns the fee applying in the epoch.
+func (c TransferFeeConfig) EpochFee(epoch uint64) TransferFee {
+	if epoch >= c.NewerTransferFee.Epoch {
+		return c.NewerTransferFee
+	}
+	return c.OlderTransferFee
+}
+
+// Returns the fee withheld from a transfer of amount in the epoch.
+func (c TransferFeeConfig) Fee(epoch uint64, amount uint64) uint64 {
+	return c.EpochFee(epoch).Fee(amount)
+}
+
+// Returns the amount received from a transfer of amount in the epoch, once the fee is withheld.
+func (c TransferFeeConfig) NetAmount(epoch uint64, amount uint64) uint64 {
+	return amount - c.Fee(epoch, amount)
+}
+
+// Returns the fee withheld from a transfer of amount: its basis points of the amount rounded up, at most MaximumFee.
+func (f TransferFee) Fee(amount uint64) uint64 {
+	basisPoints := min(uint64(f.TransferFeeBasisPoints), MaxFeeBasisPoints)
+	if basisPoints == 0 || amount == 0 {
+		return 0
+	}
+	//The product takes up to 78 bits. Divided by the basis points' scale, the fee is at most the amount.
+	hi, lo := bits.Mul64(amount, basisPoints)
+	lo, carry := bits.Add64(lo, MaxFeeBasisPoints-1, 0)
+	fee, _ := bits.Div64(hi+carry, lo, MaxFeeBasisPoints)
+	return min(fee, f.MaximumFee)
+}
+
+// Decodes the extensions of a Token-2022 mint or token account from its whole data. Data without extensions has none.
+func ParseExtensions(data []byte) ([]Extension, error) {
+	if len(data) <= AccountSize || len(data) == MultisigSize {
+		return nil, nil
+	}
+	//Extensions follow the account type, after the base layout of token accounts, which mints are padded to
+	data = data[AccountSize+1:]
+	var extensions []Extension
+	for len(data) >= 4 {
+		extensionType := ExtensionType(binary.LittleEndian.Uint16(data[0:2]))
+		length := int(binary.LittleEndian.Uint16(data[2:4]))
+		//The rest is padding
+		if extensionType == ExtensionUninitialized {
+			break
+		}
+		if len(data) < 4+length {
+			return nil, ErrInvalidExtension
+		}
+		extension, err := parseExtension(extensionType, data[4:4+length])
+		if err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, extension)
+		data = data[4+length:]
+	}
+	return extensions, nil
+}
+
+// Sizes of the extensions with a fixed layout.
+var extensionSizes = map[ExtensionType]int{
+	ExtensionTransferFeeConfig:      108,
+	ExtensionTransferFeeAmount:      8,
+	ExtensionMintCloseAuthority:     32,
+	ExtensionDefaultAccountState:    1,
+	ExtensionImmutableOwner:         0,
+	ExtensionMemoTransfer:           1,
+	ExtensionNonTransferable:        0,
+	ExtensionInterestBearingConfig:  52,
+	ExtensionCpiGuard:               1,
+	ExtensionPermanentDelegate:      32,
+	ExtensionNonTransferableAccount: 0,
+	ExtensionTransferHook:           64,
+	ExtensionTransferHookAccount:    1,
+	ExtensionMetadataPointer:        64,
+}
+
+func parseExtension(extensionType ExtensionType, data []byte) (Extension, error) {
+	if size, ok := extensionSizes[extensionType]; ok && len(data) != size {
+		return nil, ErrInvalidExtension
+	}
+	le := binary.LittleEndian
+	switch extensionType {
+	case ExtensionTransferFeeConfig:
+		return TransferFeeConfig{
+			TransferFeeConfigAuthority: readNonZeroPubkey(data[0:32]),
+			WithdrawWithheldAuthority:  readNonZeroPubkey(data[32:64]),
+			WithheldAmount:             le.Uint64(data[64:72]),
+			OlderTransferFee:           TransferFee{Epoch: le.Uint64(data[72:80]), MaximumFee: le.Uint64(data[80:88]), TransferFeeBasisPoints: le.Uint16(data[88:90])},
+			NewerTransferFee:           TransferFee{Epoch: le.Uint64(data[90:98]), MaximumFee: le.Uint64(data[98:106]), TransferFeeBasisPoints: le.Uint16(data[106:108])},
+		}, nil
+	case ExtensionTransferFeeAmount:
+		return TransferFeeAmount{WithheldAmount: le.Uint64(data)}, nil
+	case ExtensionMintCloseAuthority:
+		return MintCloseAuthority{CloseAuthority: readNonZeroPubkey(data)}, nil
+	case ExtensionDefaultAccountState:
+		return DefaultAccountState{State: AccountState(data[0])}, nil
+	case ExtensionImmutableOwner:
+		return ImmutableOwner{}, nil
+	case ExtensionMemoTransfer:
+		return MemoTransfer{RequireIncomingTransferMemos: data[0] != 0}, nil
+	case ExtensionNonTransferable:
+		return NonTransferable{}, nil
+	case ExtensionInterestBearingConfig:
+		return InterestBearingConfig{
+			RateAuthority:           readNonZeroPubkey(data[0:32]),
+			InitializationTimestamp: int64(le.Uint64(data[32:40])),
+			PreUpdateAverageRate:    int16(le.Uint16(data[40:42])),
+			LastUpdateTimestamp:     int64(le.Uint64(data[42:50])),
+			CurrentRate:             int16(le.Uint16(data[50:52])),
+		}, nil
+	case ExtensionCpiGuard:
+		return CpiGuard{LockCpi: data[0] != 0}, nil
+	case ExtensionPermanentDelegate:
+		return PermanentDelegate{Delegate: readNonZeroPubkey(data)}, nil
+	case ExtensionNonTransferableAccount:
+		return NonTransferableAccount{}, nil
+	case ExtensionTransferHook:
+		return TransferHook{Authority: readNonZeroPubkey(data[0:32]), ProgramID: readNonZeroPubkey(data[32:64])}, nil
+	case ExtensionTransferHookAccount:
+		return TransferHookAccount{Transferring: data[0] != 0}, nil
+	case ExtensionMetadataPointer:
+		return MetadataPointer{Authority: readNonZeroPubkey(data[0:32]), MetadataAddress: readNonZeroPubkey(data[32:64])}, nil
+	case ExtensionTokenMetadata:
+		return parseTokenMetadata(data)
+	}
+	return UnknownExtension{ExtensionType: extensionType, Data: data}, nil
+}
+
+// Token metadata is borsh encoded, and takes as much space as its strings.
+func parseTokenMetadata(data []byte) (TokenMetadata, error) {
+	var metadata struct {
+		UpdateAuthority    [32]byte
+		Mint               [32]byte
+		Name               string
+		Symbol             string
+		Uri                string
+		AdditionalMetadata []MetadataKeyValue
+	}
+	if err := borsh.Deserialize(&metadata, data); err != nil {
+		return TokenMetadata{}, ErrInvalidExtension
+	}
+	return TokenMetadata{
+		UpdateAuthority:    readNonZeroPubkey(metadata.UpdateAuthority[:]),
+		Mint:               readNonZeroPubkey(metadata.Mint[:]),
+		Name:               metadata.Name,
+		Symbol:             metadata.Symbol,
+		Uri:                metadata.Uri,
+		AdditionalMetadata: metadata.AdditionalMetadata,
+	}, nil
+}
+
+// Reads an optional pubkey the way extensions store it: 32 bytes, all zeros if there is none.
+func readNonZeroPubkey(data []byte) solana.Pubkey {
+	for _, b := range data {
+		if b != 0 {
+			//32 bytes always make a pubkey
+			pubkey, _ := solana.ParsePubkeyBytes(data)
+			return pubkey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package token
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/near/borsh-go"
+)
+
+// Returns the data of a Token-2022 account of the account type, with the extensions given as TLV entries.
+func extensionAccountData(base []byte, accountType byte, entries ...[]byte) []byte {
+	data := make([]byte, AccountSize+1)
+	copy(data, base)
+	data[AccountSize] = accountType
+	for _, entry := range entries {
+		data = append(data, entry...)
+	}
+	return data
+}
+
+func extensionEntry(extensionType ExtensionType, value []byte) []byte {
+	entry := binary.LittleEndian.AppendUint16(nil, uint16(extensionType))
+	entry = binary.LittleEndian.AppendUint16(entry, uint16(len(value)))
+	return append(entry, value...)
+}
+
+func TestParseMintExtensions(t *testing.T) {
+	base := make([]byte, MintSize)
+	data := make([]byte, 0, 108)
+	data = append(data, testOwner.Bytes()...)
+	data = append(data, make([]byte, 32)...)
+	data = binary.LittleEndian.AppendUint64(data, 9)
+	data = binary.LittleEndian.AppendUint64(data, 0)
+	data = binary.LittleEndian.AppendUint64(data, 0)
+	data = binary.LittleEndian.AppendUint16(data, 0)
+	data = binary.LittleEndian.AppendUint64(data, 10)
+	data = binary.LittleEndian.AppendUint64(data, 5000)
+	data = binary.LittleEndian.AppendUint16(data, 100)
+	base[44] = 6
+	base[45] = 1
+
+	var authority, mint [32]byte
+	copy(authority[:], testOwner.Bytes())
+	copy(mint[:], testMint.Bytes())
+	metadata, err := borsh.Serialize(struct {
+		UpdateAuthority    [32]byte
+		Mint               [32]byte
+		Name               string
+		Symbol             string
+		Uri                string
+		AdditionalMetadata []MetadataKeyValue
+	}{authority, mint, "USD Coin", "USDC", "https://example.com", []MetadataKeyValue{{Key: "issuer", Value: "circle"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := extensionAccountData(base, accountTypeMint,
+		extensionEntry(ExtensionTransferFeeConfig, data),
+		extensionEntry(ExtensionMetadataPointer, append(make([]byte, 32), testMint.Bytes()...)),
+		extensionEntry(ExtensionTokenMetadata, metadata),
+		extensionEntry(ExtensionConfidentialTransferMint, []byte{1, 2}),
+		make([]byte, 4),
+	)
+	parsed, err := ParseMint(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Decimals != 6 || len(parsed.Extensions) != 4 {
+		t.Fatal("Unexpected mint", parsed)
+	}
+
+	config, ok := GetExtension[TransferFeeConfig](parsed.Extensions)
+	if !ok || config.TransferFeeConfigAuthority.String() != testOwner.String() || config.WithdrawWithheldAuthority != nil || config.WithheldAmount != 9 {
+		t.Fatal("Unexpected transfer fee config", config)
+	}
+	if config.EpochFee(9) != config.OlderTransferFee || config.EpochFee(10).TransferFeeBasisPoints != 100 {
+		t.Fatal("Unexpected fee by epoch", config)
+	}
+	if config.NetAmount(10, 1000) != 990 || config.NetAmount(9, 1000) != 1000 {
+		t.Fatal("Unexpected net amount", config.NetAmount(10, 1000), config.NetAmount(9, 1000))
+	}
+
+	pointer, ok := GetExtension[MetadataPointer](parsed.Extensions)
+	if !ok || pointer.Authority != nil || pointer.MetadataAddress.String() != testMint.String() {
+		t.Fatal("Unexpected metadata pointer", pointer)
+	}
+	tokenMetadata, ok := GetExtension[TokenMetadata](parsed.Extensions)
+	if !ok || tokenMetadata.UpdateAuthority.String() != testOwner.String() || tokenMetadata.Mint.String() != testMint.String() {
+		t.Fatal("Unexpected token metadata", tokenMetadata)
+	}
+	if tokenMetadata.Name != "USD Coin" || tokenMetadata.Symbol != "USDC" || tokenMetadata.Uri != "https://example.com" || !reflect.DeepEqual(tokenMetadata.AdditionalMetadata, []MetadataKeyValue{{Key: "issuer", Value: "circle"}}) {
+		t.Fatal("Unexpected token metadata fields", tokenMetadata)
+	}
+	unknown, ok := GetExtension[UnknownExtension](parsed.Extensions)
+	if !ok || unknown.Type() != ExtensionConfidentialTransferMint || len(unknown.Data) != 2 {
+		t.Fatal("Unexpected unknown extension", unknown)
+	}
+	if _, ok := GetExtension[InterestBearingConfig](parsed.Extensions); ok {
+		t.Fatal("Expected no interest bearing config")
+	}
+
+	if _, err := ParseTokenAccount(account); !errors.Is(err, ErrInvalidAccountSize) {
+		t.Fatal("Expected a mint not to decode as a token account, got", err)
+	}
+	if _, err := ParseMint(make([]byte, MultisigSize)); !errors.Is(err, ErrInvalidAccountSize) {
+		t.Fatal("Expected a multisig not to decode as a mint, got", err)
+	}
+	if _, err := ParseMint(extensionAccountData(base, accountTypeMint, extensionEntry(ExtensionMintCloseAuthority, []byte{1}))); !errors.Is(err, ErrInvalidExtension) {
+		t.Fatal("Expected a truncated extension to fail, got", err)
+	}
+}
+
+func TestParseTokenAccountExtensions(t *testing.T) {
+	base := make([]byte, AccountSize)
+	copy(base[0:32], testMint.Bytes())
+	copy(base[32:64], testOwner.Bytes())
+	base[108] = byte(AccountStateInitialized)
+
+	account, err := ParseTokenAccount(extensionAccountData(base, accountTypeAccount,
+		extensionEntry(ExtensionImmutableOwner, nil),
+		extensionEntry(ExtensionTransferFeeAmount, binary.LittleEndian.AppendUint64(nil, 12)),
+		extensionEntry(ExtensionMemoTransfer, []byte{1}),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Extension{ImmutableOwner{}, TransferFeeAmount{WithheldAmount: 12}, MemoTransfer{RequireIncomingTransferMemos: true}}
+	if !reflect.DeepEqual(account.Extensions, expected) || account.Mint.String() != testMint.String() {
+		t.Fatal("Unexpected token account", account)
+	}
+
+	if account, err := ParseTokenAccount(base); err != nil || account.Extensions != nil {
+		t.Fatal("Expected a Token Program account to have no extensions", account, err)
+	}
+}
+
+func TestTransferFee(t *testing.T) {
+	tests := []struct {
+		name     string
+		fee      TransferFee
+		amount   uint64
+		expected uint64
+	}{
+		{"rounds up", TransferFee{TransferFeeBasisPoints: 1, MaximumFee: math.MaxUint64}, 1, 1},
+		{"exact", TransferFee{TransferFeeBasisPoints: 250, MaximumFee: math.MaxUint64}, 10_000, 250},
+		{"capped", TransferFee{TransferFeeBasisPoints: 250, MaximumFee: 100}, 10_000, 100},
+		{"no fee", TransferFee{MaximumFee: 100}, 10_000, 0},
+		{"whole amount", TransferFee{TransferFeeBasisPoints: MaxFeeBasisPoints + 1, MaximumFee: math.MaxUint64}, 77, 77},
+		{"largest amount", TransferFee{TransferFeeBasisPoints: 5000, MaximumFee: math.MaxUint64}, math.MaxUint64, math.MaxUint64/2 + 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if fee := test.fee.Fee(test.amount); fee != test.expected {
+				t.Fatal("Expected fee", test.expected, "got", fee)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package token
+
+import (
+	"github.com/hwsimmons17/solana-web3.go"
+	"github.com/near/borsh-go"
+)
+
+// Instruction discriminants of the Token Program, encoded as a u8.
+const (
+	instructionTransfer            uint8 = 3
+	instructionApprove             uint8 = 4
+	instructionRevoke              uint8 = 5
+	instructionSetAuthority        uint8 = 6
+	instructionMintTo              uint8 = 7
+	instructionBurn                uint8 = 8
+	instructionCloseAccount        uint8 = 9
+	instructionFreezeAccount       uint8 = 10
+	instructionThawAccount         uint8 = 11
+	instructionTransferChecked     uint8 = 12
+	instructionApproveChecked      uint8 = 13
+	instructionMintToChecked       uint8 = 14
+	instructionBurnChecked         uint8 = 15
+	instructionSyncNative          uint8 = 17
+	instructionInitializeAccount3  uint8 = 18
+	instructionInitializeMultisig2 uint8 = 19
+	instructionInitializeMint2     uint8 = 20
+)
+
+// Authority changed by SetAuthority.
+type AuthorityType uint8
+
+const (
+	AuthorityMintTokens    AuthorityType = iota //Mints new tokens of a mint
+	AuthorityFreezeAccount                      //Freezes and thaws the token accounts of a mint
+	AuthorityAccountOwner                       //Owns a token account
+	AuthorityCloseAccount                       //Closes a token account
+)
+
+// Instructions of the Token Program. Instructions taking an owner or authority accept multisigSigners: without them the owner or authority signs, with them it is a Multisig account and the given signers sign for it.
+type TokenIxs interface {
+	InitializeMint2(mint solana.Pubkey, decimals uint8, mintAuthority solana.Pubkey, freezeAuthority solana.Pubkey) solana.Instruction                                                            //Initializes a mint, created with MintSize bytes and owned by the Token Program. freezeAuthority may be nil.
+	InitializeAccount3(account solana.Pubkey, mint solana.Pubkey, owner solana.Pubkey) solana.Instruction                                                                                         //Initializes a token account of mint owned by owner, created with AccountSize bytes and owned by the Token Program
+	InitializeMultisig2(multisig solana.Pubkey, m uint8, signers ...solana.Pubkey) solana.Instruction                                                                                             //Initializes a multisig requiring m of the signers, created with MultisigSize bytes and owned by the Token Program. At most MaxSigners signers.
+	Transfer(source solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction                                            //Transfers tokens between token accounts of the same mint. Prefer TransferChecked.
+	TransferChecked(source solana.Pubkey, mint solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction //Transfers tokens between token accounts of mint, failing unless decimals matches the mint's
+	Approve(source solana.Pubkey, delegate solana.Pubkey, owner solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction                                                //Allows delegate to transfer and burn up to amount tokens of the source account
+	ApproveChecked(source solana.Pubkey, mint solana.Pubkey, delegate solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction     //Like Approve, failing unless decimals matches the mint's
+	Revoke(source solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                                                        //Removes the delegate of the source account
+	SetAuthority(account solana.Pubkey, currentAuthority solana.Pubkey, authorityType AuthorityType, newAuthority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction             //Changes an authority of a mint or token account. A nil newAuthority removes it for good.
+	MintTo(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction                                            //Mints new tokens to a token account of mint. Prefer MintToChecked.
+	MintToChecked(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction                     //Like MintTo, failing unless decimals matches the mint's
+	Burn(account solana.Pubkey, mint solana.Pubkey, owner solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction                                                      //Burns tokens of a token account, reducing the mint's supply. Prefer BurnChecked.
+	BurnChecked(account solana.Pubkey, mint solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction                               //Like Burn, failing unless decimals matches the mint's
+	CloseAccount(account solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                      //Closes a token account holding no tokens, sending its lamports to destination
+	FreezeAccount(account solana.Pubkey, mint solana.Pubkey, freezeAuthority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                  //Freezes a token account, so its tokens cannot be moved
+	ThawAccount(account solana.Pubkey, mint solana.Pubkey, freezeAuthority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                    //Thaws a frozen token account
+	SyncNative(account solana.Pubkey) solana.Instruction                                                                                                                                          //Sets the amount of a wrapped SOL token account to its lamports above the rent exemption
+}
+
+func TokenInstructions() TokenIxs {
+	return &tokenIxs{programID: solana.TokenProgram}
+}
+
+type tokenIxs struct {
+	programID solana.Pubkey
+}
+
+func (t tokenIxs) InitializeMint2(mint solana.Pubkey, decimals uint8, mintAuthority solana.Pubkey, freezeAuthority solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction   uint8
+		Decimals      uint8
+		MintAuthority [32]byte
+	}{
+		Instruction:   instructionInitializeMint2,
+		Decimals:      decimals,
+		MintAuthority: [32]byte(mintAuthority.Bytes()),
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendPubkeyOption(data, freezeAuthority),
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) InitializeAccount3(account solana.Pubkey, mint solana.Pubkey, owner solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Owner       [32]byte
+	}{
+		Instruction: instructionInitializeAccount3,
+		Owner:       [32]byte(owner.Bytes()),
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  []solana.AccountMeta{{Pubkey: account, Writable: true}, {Pubkey: mint}},
+	}
+}
+
+func (t tokenIxs) InitializeMultisig2(multisig solana.Pubkey, m uint8, signers ...solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		M           uint8
+	}{
+		Instruction: instructionInitializeMultisig2,
+		M:           m,
+	})
+
+	accounts := []solana.AccountMeta{{Pubkey: multisig, Writable: true}}
+	for _, signer := range signers {
+		accounts = append(accounts, solana.AccountMeta{Pubkey: signer})
+	}
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  accounts,
+	}
+}
+
+func (t tokenIxs) Transfer(source solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.amountInstruction(instructionTransfer, amount, []solana.AccountMeta{
+		{Pubkey: source, Writable: true},
+		{Pubkey: destination, Writable: true},
+	}, owner, multisigSigners)
+}
+
+func (t tokenIxs) TransferChecked(source solana.Pubkey, mint solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.checkedInstruction(instructionTransferChecked, amount, decimals, []solana.AccountMeta{
+		{Pubkey: source, Writable: true},
+		{Pubkey: mint},
+		{Pubkey: destination, Writable: true},
+	}, owner, multisigSigners)
+}
+
+func (t tokenIxs) Approve(source solana.Pubkey, delegate solana.Pubkey, owner solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.amountInstruction(instructionApprove, amount, []solana.AccountMeta{
+		{Pubkey: source, Writable: true},
+		{Pubkey: delegate},
+	}, owner, multisigSigners)
+}
+
+func (t tokenIxs) ApproveChecked(source solana.Pubkey, mint solana.Pubkey, delegate solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.checkedInstruction(instructionApproveChecked, amount, decimals, []solana.AccountMeta{
+		{Pubkey: source, Writable: true},
+		{Pubkey: mint},
+		{Pubkey: delegate},
+	}, owner, multisigSigners)
+}
+
+func (t tokenIxs) Revoke(source solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionRevoke},
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: source, Writable: true}}, owner, multisigSigners),
+	}
+}
+
+func (t tokenIxs) SetAuthority(account solana.Pubkey, currentAuthority solana.Pubkey, authorityType AuthorityType, newAuthority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	data := appendPubkeyOption([]byte{instructionSetAuthority, byte(authorityType)}, newAuthority)
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: account, Writable: true}}, currentAuthority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) MintTo(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.amountInstruction(instructionMintTo, amount, []solana.AccountMeta{
+		{Pubkey: mint, Writable: true},
+		{Pubkey: destination, Writable: true},
+	}, authority, multisigSigners)
+}
+
+func (t tokenIxs) MintToChecked(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.checkedInstruction(instructionMintToChecked, amount, decimals, []solana.AccountMeta{
+		{Pubkey: mint, Writable: true},
+		{Pubkey: destination, Writable: true},
+	}, authority, multisigSigners)
+}
+
+func (t tokenIxs) Burn(account solana.Pubkey, mint solana.Pubkey, owner solana.Pubkey, amount uint64, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.amountInstruction(instructionBurn, amount, []solana.AccountMeta{
+		{Pubkey: account, Writable: true},
+		{Pubkey: mint, Writable: true},
+	}, owner, multisigSigners)
+}
+
+func (t tokenIxs) BurnChecked(account solana.Pubkey, mint solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return t.checkedInstruction(instructionBurnChecked, amount, decimals, []solana.AccountMeta{
+		{Pubkey: account, Writable: true},
+		{Pubkey: mint, Writable: true},
+	}, owner, multisigSigners)
+}
+
+func (t tokenIxs) CloseAccount(account solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionCloseAccount},
+		Accounts: withAuthority([]solana.AccountMeta{
+			{Pubkey: account, Writable: true},
+			{Pubkey: destination, Writable: true},
+		}, owner, multisigSigners),
+	}
+}
+
+func (t tokenIxs) FreezeAccount(account solana.Pubkey, mint solana.Pubkey, freezeAuthority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionFreezeAccount},
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: account, Writable: true}, {Pubkey: mint}}, freezeAuthority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) ThawAccount(account solana.Pubkey, mint solana.Pubkey, freezeAuthority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionThawAccount},
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: account, Writable: true}, {Pubkey: mint}}, freezeAuthority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) SyncNative(account solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionSyncNative},
+		Accounts:  []solana.AccountMeta{{Pubkey: account, Writable: true}},
+	}
+}
+
+// Returns an instruction whose data is an amount, signed by authority or its multisig signers.
+func (t tokenIxs) amountInstruction(instruction uint8, amount uint64, accounts []solana.AccountMeta, authority solana.Pubkey, multisigSigners []solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Amount      uint64
+	}{
+		Instruction: instruction,
+		Amount:      amount,
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  withAuthority(accounts, authority, multisigSigners),
+	}
+}
+
+// Returns an instruction whose data is an amount and the decimals of its mint, signed by authority or its multisig signers.
+func (t tokenIxs) checkedInstruction(instruction uint8, amount uint64, decimals uint8, accounts []solana.AccountMeta, authority solana.Pubkey, multisigSigners []solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Amount      uint64
+		Decimals    uint8
+	}{
+		Instruction: instruction,
+		Amount:      amount,
+		Decimals:    decimals,
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  withAuthority(accounts, authority, multisigSigners),
+	}
+}
+
+// Appends the authority of an instruction to its accounts. A single authority signs, a multisig authority is followed by the signers signing for it.
+func withAuthority(accounts []solana.AccountMeta, authority solana.Pubkey, multisigSigners []solana.Pubkey) []solana.AccountMeta {
+	accounts = append(accounts, solana.AccountMeta{Pubkey: authority, Signer: len(multisigSigners) == 0})
+	for _, signer := range multisigSigners {
+		accounts = append(accounts, solana.AccountMeta{Pubkey: signer, Signer: true})
+	}
+	return accounts
+}
+
+// Appends an optional pubkey the way the Token Program encodes it in instructions: a 1 byte tag, followed by the pubkey if the tag is 1.
+func appendPubkeyOption(data []byte, pubkey solana.Pubkey) []byte {
+	if pubkey == nil {
+		return append(data, 0)
+	}
+	return append(append(data, 1), pubkey.Bytes()...)
+}
@@ -0,0 +1,79 @@
+package token
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+var (
+	testMint      = solana.MustParsePubkey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	testOwner     = solana.MustParsePubkey("5oNDL3swdJJF1g9DzJiZ4ynHXgszjAEpUkxVYejchzrQ")
+	testSource    = solana.MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY")
+	testRecipient = solana.MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+)
+
+func TestTokenInstructions(t *testing.T) {
+	ixs := TokenInstructions()
+	tests := []struct {
+		name        string
+		instruction solana.Instruction
+		data        []byte
+		accounts    int
+	}{
+		{"InitializeMint2", ixs.InitializeMint2(testMint, 6, testOwner, nil), append(append([]byte{20, 6}, testOwner.Bytes()...), 0), 1},
+		{"InitializeMint2 with freeze authority", ixs.InitializeMint2(testMint, 6, testOwner, testSource), append(append(append([]byte{20, 6}, testOwner.Bytes()...), 1), testSource.Bytes()...), 1},
+		{"InitializeAccount3", ixs.InitializeAccount3(testSource, testMint, testOwner), append([]byte{18}, testOwner.Bytes()...), 2},
+		{"InitializeMultisig2", ixs.InitializeMultisig2(testMint, 2, testOwner, testSource, testRecipient), []byte{19, 2}, 4},
+		{"Transfer", ixs.Transfer(testSource, testRecipient, testOwner, 100), []byte{3, 100, 0, 0, 0, 0, 0, 0, 0}, 3},
+		{"TransferChecked", ixs.TransferChecked(testSource, testMint, testRecipient, testOwner, 100, 6), []byte{12, 100, 0, 0, 0, 0, 0, 0, 0, 6}, 4},
+		{"Approve", ixs.Approve(testSource, testRecipient, testOwner, 1), []byte{4, 1, 0, 0, 0, 0, 0, 0, 0}, 3},
+		{"ApproveChecked", ixs.ApproveChecked(testSource, testMint, testRecipient, testOwner, 1, 6), []byte{13, 1, 0, 0, 0, 0, 0, 0, 0, 6}, 4},
+		{"Revoke", ixs.Revoke(testSource, testOwner), []byte{5}, 2},
+		{"SetAuthority", ixs.SetAuthority(testMint, testOwner, AuthorityFreezeAccount, nil), []byte{6, 1, 0}, 2},
+		{"MintTo", ixs.MintTo(testMint, testRecipient, testOwner, 2), []byte{7, 2, 0, 0, 0, 0, 0, 0, 0}, 3},
+		{"MintToChecked", ixs.MintToChecked(testMint, testRecipient, testOwner, 2, 9), []byte{14, 2, 0, 0, 0, 0, 0, 0, 0, 9}, 3},
+		{"Burn", ixs.Burn(testSource, testMint, testOwner, 3), []byte{8, 3, 0, 0, 0, 0, 0, 0, 0}, 3},
+		{"BurnChecked", ixs.BurnChecked(testSource, testMint, testOwner, 3, 9), []byte{15, 3, 0, 0, 0, 0, 0, 0, 0, 9}, 3},
+		{"CloseAccount", ixs.CloseAccount(testSource, testRecipient, testOwner), []byte{9}, 3},
+		{"FreezeAccount", ixs.FreezeAccount(testSource, testMint, testOwner), []byte{10}, 3},
+		{"ThawAccount", ixs.ThawAccount(testSource, testMint, testOwner), []byte{11}, 3},
+		{"SyncNative", ixs.SyncNative(testSource), []byte{17}, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.instruction.ProgramID.String() != solana.TokenProgram.String() {
+				t.Fatal("Unexpected program", test.instruction.ProgramID)
+			}
+			if slices.Compare(test.instruction.Data, test.data) != 0 {
+				t.Fatal("Expected data", test.data, "got", test.instruction.Data)
+			}
+			if len(test.instruction.Accounts) != test.accounts || !test.instruction.Accounts[0].Writable {
+				t.Fatal("Unexpected accounts", test.instruction.Accounts)
+			}
+		})
+	}
+}
+
+func TestMultisigAuthority(t *testing.T) {
+	multisig := testMint
+	ix := TokenInstructions().TransferChecked(testSource, testMint, testRecipient, multisig, 100, 6, testOwner, testRecipient)
+	if len(ix.Accounts) != 6 {
+		t.Fatal("Expected the multisig signers after the multisig", ix.Accounts)
+	}
+	authority := ix.Accounts[3]
+	if authority.Pubkey.String() != multisig.String() || authority.Signer || authority.Writable {
+		t.Fatal("Expected the multisig not to sign", authority)
+	}
+	for _, signer := range ix.Accounts[4:] {
+		if !signer.Signer || signer.Writable {
+			t.Fatal("Expected the multisig signers to sign", signer)
+		}
+	}
+
+	ix = TokenInstructions().TransferChecked(testSource, testMint, testRecipient, testOwner, 100, 6)
+	if !ix.Accounts[3].Signer {
+		t.Fatal("Expected the owner to sign")
+	}
+}
@@ -0,0 +1,395 @@
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/hwsimmons17/solana-web3.go"
+	"github.com/near/borsh-go"
+)
+
+// Instruction discriminants of the Token-2022 extensions, encoded as a u8. Extensions with several instructions follow with the discriminant of the instruction as another u8.
+const (
+	instructionInitializeImmutableOwner      uint8 = 22
+	instructionInitializeMintCloseAuthority  uint8 = 25
+	instructionTransferFeeExtension          uint8 = 26
+	instructionDefaultAccountStateExtension  uint8 = 28
+	instructionReallocate                    uint8 = 29
+	instructionMemoTransferExtension         uint8 = 30
+	instructionInitializeNonTransferableMint uint8 = 32
+	instructionInterestBearingMintExtension  uint8 = 33
+	instructionInitializePermanentDelegate   uint8 = 35
+	instructionTransferHookExtension         uint8 = 36
+	instructionMetadataPointerExtension      uint8 = 39
+)
+
+// Instructions of the token metadata interface, which Token-2022 implements for metadata stored in the mint, are told apart by the first 8 bytes of the SHA-256 hash of their name.
+var (
+	metadataInitialize      = metadataDiscriminator("spl_token_metadata_interface:initialize_account")
+	metadataUpdateField     = metadataDiscriminator("spl_token_metadata_interface:updating_field")
+	metadataRemoveKey       = metadataDiscriminator("spl_token_metadata_interface:remove_key_ix")
+	metadataUpdateAuthority = metadataDiscriminator("spl_token_metadata_interface:update_the_authority")
+)
+
+// Field of token metadata changed by UpdateTokenMetadataField. Any other value is the key of an additional field.
+type MetadataField string
+
+const (
+	MetadataFieldName   MetadataField = "name"
+	MetadataFieldSymbol MetadataField = "symbol"
+	MetadataFieldUri    MetadataField = "uri"
+)
+
+// Instructions of Token-2022: those of the Token Program, and those of its extensions. Extensions of a mint are initialized before the mint itself, extensions of a token account after it.
+type Token2022Ixs interface {
+	TokenIxs
+
+	InitializeMintCloseAuthority(mint solana.Pubkey, closeAuthority solana.Pubkey) solana.Instruction                                                                                                                //Lets closeAuthority close the mint once its supply is zero
+	InitializeTransferFeeConfig(mint solana.Pubkey, transferFeeConfigAuthority solana.Pubkey, withdrawWithheldAuthority solana.Pubkey, transferFeeBasisPoints uint16, maximumFee uint64) solana.Instruction          //Charges a fee on transfers of the mint's tokens. Either authority may be nil.
+	TransferCheckedWithFee(source solana.Pubkey, mint solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, fee uint64, multisigSigners ...solana.Pubkey) solana.Instruction //Like TransferChecked, failing unless fee matches the fee withheld, as computed by TransferFeeConfig.Fee
+	WithdrawWithheldTokensFromMint(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                      //Moves the fees harvested to the mint to destination
+	WithdrawWithheldTokensFromAccounts(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, sources []solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                         //Moves the fees withheld in the source token accounts to destination
+	HarvestWithheldTokensToMint(mint solana.Pubkey, sources ...solana.Pubkey) solana.Instruction                                                                                                                     //Moves the fees withheld in the source token accounts to the mint. Anyone may harvest.
+	SetTransferFee(mint solana.Pubkey, authority solana.Pubkey, transferFeeBasisPoints uint16, maximumFee uint64, multisigSigners ...solana.Pubkey) solana.Instruction                                               //Changes the transfer fee, from two epochs later on
+	InitializeDefaultAccountState(mint solana.Pubkey, state AccountState) solana.Instruction                                                                                                                         //Sets the state new token accounts of the mint start in
+	UpdateDefaultAccountState(mint solana.Pubkey, freezeAuthority solana.Pubkey, state AccountState, multisigSigners ...solana.Pubkey) solana.Instruction                                                            //Changes the state new token accounts of the mint start in
+	InitializeImmutableOwner(account solana.Pubkey) solana.Instruction                                                                                                                                               //Prevents the owner of a token account from changing. Must come before InitializeAccount3.
+	Reallocate(account solana.Pubkey, payer solana.Pubkey, owner solana.Pubkey, extensionTypes []ExtensionType, multisigSigners ...solana.Pubkey) solana.Instruction                                                 //Grows a token account to make room for the extensions, payer funding its rent exemption
+	EnableRequiredMemoTransfers(account solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                                                     //Requires transfers to the token account to be preceded by a memo
+	DisableRequiredMemoTransfers(account solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                                                    //Stops requiring memos on transfers to the token account
+	InitializeNonTransferableMint(mint solana.Pubkey) solana.Instruction                                                                                                                                             //Prevents the mint's tokens from being transferred
+	InitializeInterestBearingMint(mint solana.Pubkey, rateAuthority solana.Pubkey, rate int16) solana.Instruction                                                                                                    //Makes the UI amount of the mint's tokens accrue interest at rate basis points a year. rateAuthority may be nil.
+	UpdateInterestRate(mint solana.Pubkey, rateAuthority solana.Pubkey, rate int16, multisigSigners ...solana.Pubkey) solana.Instruction                                                                             //Changes the interest rate, in basis points a year
+	InitializePermanentDelegate(mint solana.Pubkey, delegate solana.Pubkey) solana.Instruction                                                                                                                       //Lets delegate transfer and burn the tokens of every token account of the mint
+	InitializeTransferHook(mint solana.Pubkey, authority solana.Pubkey, programID solana.Pubkey) solana.Instruction                                                                                                  //Invokes programID on every transfer of the mint's tokens. authority may be nil.
+	UpdateTransferHook(mint solana.Pubkey, authority solana.Pubkey, programID solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                                    //Changes the program invoked on transfers. A nil programID removes it.
+	InitializeMetadataPointer(mint solana.Pubkey, authority solana.Pubkey, metadataAddress solana.Pubkey) solana.Instruction                                                                                         //Points to the account holding the mint's metadata, the mint itself to store TokenMetadata in it. authority may be nil.
+	UpdateMetadataPointer(mint solana.Pubkey, authority solana.Pubkey, metadataAddress solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction                                                           //Changes the account holding the mint's metadata
+	InitializeTokenMetadata(metadata solana.Pubkey, updateAuthority solana.Pubkey, mint solana.Pubkey, mintAuthority solana.Pubkey, name string, symbol string, uri string) solana.Instruction                       //Stores metadata in metadata, the mint itself once its metadata pointer points to it. The mint authority signs.
+	UpdateTokenMetadataField(metadata solana.Pubkey, updateAuthority solana.Pubkey, field MetadataField, value string) solana.Instruction                                                                            //Sets a field of the metadata, adding it if it is a new additional field
+	RemoveTokenMetadataKey(metadata solana.Pubkey, updateAuthority solana.Pubkey, key string, idempotent bool) solana.Instruction                                                                                    //Removes an additional field of the metadata. Unless idempotent, fails if there is no such field.
+	UpdateTokenMetadataAuthority(metadata solana.Pubkey, updateAuthority solana.Pubkey, newAuthority solana.Pubkey) solana.Instruction                                                                               //Hands the metadata over to newAuthority. A nil newAuthority makes it immutable.
+}
+
+func Token2022Instructions() Token2022Ixs {
+	return &tokenIxs{programID: solana.Token2022Program}
+}
+
+func (t tokenIxs) InitializeMintCloseAuthority(mint solana.Pubkey, closeAuthority solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendPubkeyOption([]byte{instructionInitializeMintCloseAuthority}, closeAuthority),
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) InitializeTransferFeeConfig(mint solana.Pubkey, transferFeeConfigAuthority solana.Pubkey, withdrawWithheldAuthority solana.Pubkey, transferFeeBasisPoints uint16, maximumFee uint64) solana.Instruction {
+	data := []byte{instructionTransferFeeExtension, 0}
+	data = appendPubkeyOption(data, transferFeeConfigAuthority)
+	data = appendPubkeyOption(data, withdrawWithheldAuthority)
+	data = binary.LittleEndian.AppendUint16(data, transferFeeBasisPoints)
+	data = binary.LittleEndian.AppendUint64(data, maximumFee)
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) TransferCheckedWithFee(source solana.Pubkey, mint solana.Pubkey, destination solana.Pubkey, owner solana.Pubkey, amount uint64, decimals uint8, fee uint64, multisigSigners ...solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction uint8
+		Extension   uint8
+		Amount      uint64
+		Decimals    uint8
+		Fee         uint64
+	}{
+		Instruction: instructionTransferFeeExtension,
+		Extension:   1,
+		Amount:      amount,
+		Decimals:    decimals,
+		Fee:         fee,
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts: withAuthority([]solana.AccountMeta{
+			{Pubkey: source, Writable: true},
+			{Pubkey: mint},
+			{Pubkey: destination, Writable: true},
+		}, owner, multisigSigners),
+	}
+}
+
+func (t tokenIxs) WithdrawWithheldTokensFromMint(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionTransferFeeExtension, 2},
+		Accounts: withAuthority([]solana.AccountMeta{
+			{Pubkey: mint, Writable: true},
+			{Pubkey: destination, Writable: true},
+		}, authority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) WithdrawWithheldTokensFromAccounts(mint solana.Pubkey, destination solana.Pubkey, authority solana.Pubkey, sources []solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	accounts := withAuthority([]solana.AccountMeta{
+		{Pubkey: mint},
+		{Pubkey: destination, Writable: true},
+	}, authority, multisigSigners)
+	for _, source := range sources {
+		accounts = append(accounts, solana.AccountMeta{Pubkey: source, Writable: true})
+	}
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionTransferFeeExtension, 3, byte(len(sources))},
+		Accounts:  accounts,
+	}
+}
+
+func (t tokenIxs) HarvestWithheldTokensToMint(mint solana.Pubkey, sources ...solana.Pubkey) solana.Instruction {
+	accounts := []solana.AccountMeta{{Pubkey: mint, Writable: true}}
+	for _, source := range sources {
+		accounts = append(accounts, solana.AccountMeta{Pubkey: source, Writable: true})
+	}
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionTransferFeeExtension, 4},
+		Accounts:  accounts,
+	}
+}
+
+func (t tokenIxs) SetTransferFee(mint solana.Pubkey, authority solana.Pubkey, transferFeeBasisPoints uint16, maximumFee uint64, multisigSigners ...solana.Pubkey) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Instruction            uint8
+		Extension              uint8
+		TransferFeeBasisPoints uint16
+		MaximumFee             uint64
+	}{
+		Instruction:            instructionTransferFeeExtension,
+		Extension:              5,
+		TransferFeeBasisPoints: transferFeeBasisPoints,
+		MaximumFee:             maximumFee,
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: mint, Writable: true}}, authority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) InitializeDefaultAccountState(mint solana.Pubkey, state AccountState) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionDefaultAccountStateExtension, 0, byte(state)},
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) UpdateDefaultAccountState(mint solana.Pubkey, freezeAuthority solana.Pubkey, state AccountState, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionDefaultAccountStateExtension, 1, byte(state)},
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: mint, Writable: true}}, freezeAuthority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) InitializeImmutableOwner(account solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionInitializeImmutableOwner},
+		Accounts:  []solana.AccountMeta{{Pubkey: account, Writable: true}},
+	}
+}
+
+func (t tokenIxs) Reallocate(account solana.Pubkey, payer solana.Pubkey, owner solana.Pubkey, extensionTypes []ExtensionType, multisigSigners ...solana.Pubkey) solana.Instruction {
+	//The extension types fill the rest of the data, without a length
+	data := []byte{instructionReallocate}
+	for _, extensionType := range extensionTypes {
+		data = binary.LittleEndian.AppendUint16(data, uint16(extensionType))
+	}
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      data,
+		Accounts: withAuthority([]solana.AccountMeta{
+			{Pubkey: account, Writable: true},
+			{Pubkey: payer, Signer: true, Writable: true},
+			{Pubkey: solana.SystemProgram},
+		}, owner, multisigSigners),
+	}
+}
+
+func (t tokenIxs) EnableRequiredMemoTransfers(account solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionMemoTransferExtension, 0},
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: account, Writable: true}}, owner, multisigSigners),
+	}
+}
+
+func (t tokenIxs) DisableRequiredMemoTransfers(account solana.Pubkey, owner solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionMemoTransferExtension, 1},
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: account, Writable: true}}, owner, multisigSigners),
+	}
+}
+
+func (t tokenIxs) InitializeNonTransferableMint(mint solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      []byte{instructionInitializeNonTransferableMint},
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) InitializeInterestBearingMint(mint solana.Pubkey, rateAuthority solana.Pubkey, rate int16) solana.Instruction {
+	data := appendNonZeroPubkey([]byte{instructionInterestBearingMintExtension, 0}, rateAuthority)
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      binary.LittleEndian.AppendUint16(data, uint16(rate)),
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) UpdateInterestRate(mint solana.Pubkey, rateAuthority solana.Pubkey, rate int16, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      binary.LittleEndian.AppendUint16([]byte{instructionInterestBearingMintExtension, 1}, uint16(rate)),
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: mint, Writable: true}}, rateAuthority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) InitializePermanentDelegate(mint solana.Pubkey, delegate solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      append([]byte{instructionInitializePermanentDelegate}, delegate.Bytes()...),
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) InitializeTransferHook(mint solana.Pubkey, authority solana.Pubkey, programID solana.Pubkey) solana.Instruction {
+	data := appendNonZeroPubkey([]byte{instructionTransferHookExtension, 0}, authority)
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendNonZeroPubkey(data, programID),
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) UpdateTransferHook(mint solana.Pubkey, authority solana.Pubkey, programID solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendNonZeroPubkey([]byte{instructionTransferHookExtension, 1}, programID),
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: mint, Writable: true}}, authority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) InitializeMetadataPointer(mint solana.Pubkey, authority solana.Pubkey, metadataAddress solana.Pubkey) solana.Instruction {
+	data := appendNonZeroPubkey([]byte{instructionMetadataPointerExtension, 0}, authority)
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendNonZeroPubkey(data, metadataAddress),
+		Accounts:  []solana.AccountMeta{{Pubkey: mint, Writable: true}},
+	}
+}
+
+func (t tokenIxs) UpdateMetadataPointer(mint solana.Pubkey, authority solana.Pubkey, metadataAddress solana.Pubkey, multisigSigners ...solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendNonZeroPubkey([]byte{instructionMetadataPointerExtension, 1}, metadataAddress),
+		Accounts:  withAuthority([]solana.AccountMeta{{Pubkey: mint, Writable: true}}, authority, multisigSigners),
+	}
+}
+
+func (t tokenIxs) InitializeTokenMetadata(metadata solana.Pubkey, updateAuthority solana.Pubkey, mint solana.Pubkey, mintAuthority solana.Pubkey, name string, symbol string, uri string) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Name   string
+		Symbol string
+		Uri    string
+	}{
+		Name:   name,
+		Symbol: symbol,
+		Uri:    uri,
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      append(metadataInitialize[:], data...),
+		Accounts: []solana.AccountMeta{
+			{Pubkey: metadata, Writable: true},
+			{Pubkey: updateAuthority},
+			{Pubkey: mint},
+			{Pubkey: mintAuthority, Signer: true},
+		},
+	}
+}
+
+func (t tokenIxs) UpdateTokenMetadataField(metadata solana.Pubkey, updateAuthority solana.Pubkey, field MetadataField, value string) solana.Instruction {
+	//Fields are a borsh enum: name, symbol, uri, or the key of an additional field
+	var data []byte
+	switch field {
+	case MetadataFieldName:
+		data = append(metadataUpdateField[:], 0)
+	case MetadataFieldSymbol:
+		data = append(metadataUpdateField[:], 1)
+	case MetadataFieldUri:
+		data = append(metadataUpdateField[:], 2)
+	default:
+		key, _ := borsh.Serialize(string(field))
+		data = append(append(metadataUpdateField[:], 3), key...)
+	}
+	encodedValue, _ := borsh.Serialize(value)
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      append(data, encodedValue...),
+		Accounts:  []solana.AccountMeta{{Pubkey: metadata, Writable: true}, {Pubkey: updateAuthority, Signer: true}},
+	}
+}
+
+func (t tokenIxs) RemoveTokenMetadataKey(metadata solana.Pubkey, updateAuthority solana.Pubkey, key string, idempotent bool) solana.Instruction {
+	data, _ := borsh.Serialize(struct {
+		Idempotent bool
+		Key        string
+	}{
+		Idempotent: idempotent,
+		Key:        key,
+	})
+
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      append(metadataRemoveKey[:], data...),
+		Accounts:  []solana.AccountMeta{{Pubkey: metadata, Writable: true}, {Pubkey: updateAuthority, Signer: true}},
+	}
+}
+
+func (t tokenIxs) UpdateTokenMetadataAuthority(metadata solana.Pubkey, updateAuthority solana.Pubkey, newAuthority solana.Pubkey) solana.Instruction {
+	return solana.Instruction{
+		ProgramID: t.programID,
+		Data:      appendNonZeroPubkey(metadataUpdateAuthority[:], newAuthority),
+		Accounts:  []solana.AccountMeta{{Pubkey: metadata, Writable: true}, {Pubkey: updateAuthority, Signer: true}},
+	}
+}
+
+// Appends an optional pubkey the way extensions encode it: 32 bytes, all zeros if there is none.
+func appendNonZeroPubkey(data []byte, pubkey solana.Pubkey) []byte {
+	if pubkey == nil {
+		return append(data, make([]byte, 32)...)
+	}
+	return append(data, pubkey.Bytes()...)
+}
+
+func metadataDiscriminator(name string) [8]byte {
+	hash := sha256.Sum256([]byte(name))
+	return [8]byte(hash[:8])
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hwsimmons17/solana-web3.go"
+)
+
+func TestToken2022Instructions(t *testing.T) {
+	ixs := Token2022Instructions()
+	zeros := make([]byte, 32)
+	tests := []struct {
+		name        string
+		instruction solana.Instruction
+		data        []byte
+		accounts    int
+	}{
+		{"TransferChecked", ixs.TransferChecked(testSource, testMint, testRecipient, testOwner, 100, 6), []byte{12, 100, 0, 0, 0, 0, 0, 0, 0, 6}, 4},
+		{"InitializeMintCloseAuthority", ixs.InitializeMintCloseAuthority(testMint, testOwner), append([]byte{25, 1}, testOwner.Bytes()...), 1},
+		{"InitializeTransferFeeConfig", ixs.InitializeTransferFeeConfig(testMint, testOwner, nil, 50, 5000), append(append([]byte{26, 0, 1}, testOwner.Bytes()...), 0, 50, 0, 0x88, 0x13, 0, 0, 0, 0, 0, 0), 1},
+		{"TransferCheckedWithFee", ixs.TransferCheckedWithFee(testSource, testMint, testRecipient, testOwner, 100, 6, 1), []byte{26, 1, 100, 0, 0, 0, 0, 0, 0, 0, 6, 1, 0, 0, 0, 0, 0, 0, 0}, 4},
+		{"WithdrawWithheldTokensFromMint", ixs.WithdrawWithheldTokensFromMint(testMint, testRecipient, testOwner), []byte{26, 2}, 3},
+		{"WithdrawWithheldTokensFromAccounts", ixs.WithdrawWithheldTokensFromAccounts(testMint, testRecipient, testOwner, []solana.Pubkey{testSource}), []byte{26, 3, 1}, 4},
+		{"HarvestWithheldTokensToMint", ixs.HarvestWithheldTokensToMint(testMint, testSource, testRecipient), []byte{26, 4}, 3},
+		{"SetTransferFee", ixs.SetTransferFee(testMint, testOwner, 10, 1), []byte{26, 5, 10, 0, 1, 0, 0, 0, 0, 0, 0, 0}, 2},
+		{"InitializeDefaultAccountState", ixs.InitializeDefaultAccountState(testMint, AccountStateFrozen), []byte{28, 0, 2}, 1},
+		{"UpdateDefaultAccountState", ixs.UpdateDefaultAccountState(testMint, testOwner, AccountStateInitialized), []byte{28, 1, 1}, 2},
+		{"InitializeImmutableOwner", ixs.InitializeImmutableOwner(testSource), []byte{22}, 1},
+		{"Reallocate", ixs.Reallocate(testSource, testRecipient, testOwner, []ExtensionType{ExtensionMemoTransfer, ExtensionCpiGuard}), []byte{29, 8, 0, 11, 0}, 4},
+		{"EnableRequiredMemoTransfers", ixs.EnableRequiredMemoTransfers(testSource, testOwner), []byte{30, 0}, 2},
+		{"DisableRequiredMemoTransfers", ixs.DisableRequiredMemoTransfers(testSource, testOwner), []byte{30, 1}, 2},
+		{"InitializeNonTransferableMint", ixs.InitializeNonTransferableMint(testMint), []byte{32}, 1},
+		{"InitializeInterestBearingMint", ixs.InitializeInterestBearingMint(testMint, nil, -5), append(append([]byte{33, 0}, zeros...), 0xfb, 0xff), 1},
+		{"UpdateInterestRate", ixs.UpdateInterestRate(testMint, testOwner, 300), []byte{33, 1, 0x2c, 0x01}, 2},
+		{"InitializePermanentDelegate", ixs.InitializePermanentDelegate(testMint, testOwner), append([]byte{35}, testOwner.Bytes()...), 1},
+		{"InitializeTransferHook", ixs.InitializeTransferHook(testMint, testOwner, testSource), append(append([]byte{36, 0}, testOwner.Bytes()...), testSource.Bytes()...), 1},
+		{"UpdateTransferHook", ixs.UpdateTransferHook(testMint, testOwner, nil), append([]byte{36, 1}, zeros...), 2},
+		{"InitializeMetadataPointer", ixs.InitializeMetadataPointer(testMint, nil, testMint), append(append([]byte{39, 0}, zeros...), testMint.Bytes()...), 1},
+		{"UpdateMetadataPointer", ixs.UpdateMetadataPointer(testMint, testOwner, testSource), append([]byte{39, 1}, testSource.Bytes()...), 2},
+		{"InitializeTokenMetadata", ixs.InitializeTokenMetadata(testMint, testOwner, testMint, testOwner, "A", "B", "C"), []byte{210, 225, 30, 162, 88, 184, 77, 141, 1, 0, 0, 0, 'A', 1, 0, 0, 0, 'B', 1, 0, 0, 0, 'C'}, 4},
+		{"UpdateTokenMetadataField", ixs.UpdateTokenMetadataField(testMint, testOwner, MetadataFieldSymbol, "X"), append(metadataUpdateField[:], 1, 1, 0, 0, 0, 'X'), 2},
+		{"UpdateTokenMetadataField with key", ixs.UpdateTokenMetadataField(testMint, testOwner, "k", "v"), append(metadataUpdateField[:], 3, 1, 0, 0, 0, 'k', 1, 0, 0, 0, 'v'), 2},
+		{"RemoveTokenMetadataKey", ixs.RemoveTokenMetadataKey(testMint, testOwner, "k", true), append(metadataRemoveKey[:], 1, 1, 0, 0, 0, 'k'), 2},
+		{"UpdateTokenMetadataAuthority", ixs.UpdateTokenMetadataAuthority(testMint, testOwner, nil), append(metadataUpdateAuthority[:], zeros...), 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.instruction.ProgramID.String() != solana.Token2022Program.String() {
+				t.Fatal("Unexpected program", test.instruction.ProgramID)
+			}
+			if slices.Compare(test.instruction.Data, test.data) != 0 {
+				t.Fatal("Expected data", test.data, "got", test.instruction.Data)
+			}
+			//Withdrawing from accounts only reads the mint
+			if len(test.instruction.Accounts) != test.accounts || !slices.ContainsFunc(test.instruction.Accounts, func(account solana.AccountMeta) bool { return account.Writable }) {
+				t.Fatal("Unexpected accounts", test.instruction.Accounts)
+			}
+		})
+	}
+}
+
+func TestTokenMetadataSigners(t *testing.T) {
+	ix := Token2022Instructions().InitializeTokenMetadata(testMint, testOwner, testMint, testSource, "A", "B", "C")
+	if ix.Accounts[1].Signer || !ix.Accounts[3].Signer || ix.Accounts[3].Pubkey.String() != testSource.String() {
+		t.Fatal("Expected only the mint authority to sign", ix.Accounts)
+	}
+	ix = Token2022Instructions().RemoveTokenMetadataKey(testMint, testOwner, "k", false)
+	if !ix.Accounts[1].Signer || ix.Data[8] != 0 {
+		t.Fatal("Expected the update authority to sign a non-idempotent removal", ix)
+	}
+}
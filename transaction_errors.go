@@ -0,0 +1,397 @@
+package solana
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Error returned by the runtime when a transaction fails. It is found in TransactionMeta.Err, SignatureStatus.Err, simulation results and failed preflight checks.
+// Errors of the same kind match with errors.Is, so the ErrTransaction* and ErrInstruction* sentinels can be used to branch on a specific failure.
+type TransactionError struct {
+	Kind             string            //Name of the TransactionError variant, e.g. "BlockhashNotFound" or "InstructionError"
+	InstructionError *InstructionError //The failed instruction, set when Kind is "InstructionError"
+	Index            *int              //Instruction index of DuplicateInstruction, or account index of InsufficientFundsForRent and ProgramExecutionTemporarilyRestricted
+}
+
+// Error returned by a single instruction of a failed transaction.
+type InstructionError struct {
+	Index   int     //Index of the instruction that failed
+	Kind    string  //Name of the InstructionError variant, e.g. "InvalidArgument" or "Custom"
+	Custom  *uint32 //Program specific error code, set when Kind is "Custom"
+	Message *string //Error message, set when Kind is "BorshIoError"
+}
+
+// Errors found in TransactionError.Kind. Use them with errors.Is.
+var (
+	ErrTransactionInstructionError                      = &TransactionError{Kind: "InstructionError"}
+	ErrTransactionAccountInUse                          = &TransactionError{Kind: "AccountInUse"}
+	ErrTransactionAccountLoadedTwice                    = &TransactionError{Kind: "AccountLoadedTwice"}
+	ErrTransactionAccountNotFound                       = &TransactionError{Kind: "AccountNotFound"}
+	ErrTransactionProgramAccountNotFound                = &TransactionError{Kind: "ProgramAccountNotFound"}
+	ErrTransactionInsufficientFundsForFee               = &TransactionError{Kind: "InsufficientFundsForFee"}
+	ErrTransactionInvalidAccountForFee                  = &TransactionError{Kind: "InvalidAccountForFee"}
+	ErrTransactionAlreadyProcessed                      = &TransactionError{Kind: "AlreadyProcessed"}
+	ErrTransactionBlockhashNotFound                     = &TransactionError{Kind: "BlockhashNotFound"}
+	ErrTransactionCallChainTooDeep                      = &TransactionError{Kind: "CallChainTooDeep"}
+	ErrTransactionMissingSignatureForFee                = &TransactionError{Kind: "MissingSignatureForFee"}
+	ErrTransactionInvalidAccountIndex                   = &TransactionError{Kind: "InvalidAccountIndex"}
+	ErrTransactionSignatureFailure                      = &TransactionError{Kind: "SignatureFailure"}
+	ErrTransactionInvalidProgramForExecution            = &TransactionError{Kind: "InvalidProgramForExecution"}
+	ErrTransactionSanitizeFailure                       = &TransactionError{Kind: "SanitizeFailure"}
+	ErrTransactionClusterMaintenance                    = &TransactionError{Kind: "ClusterMaintenance"}
+	ErrTransactionAccountBorrowOutstanding              = &TransactionError{Kind: "AccountBorrowOutstanding"}
+	ErrTransactionWouldExceedMaxBlockCostLimit          = &TransactionError{Kind: "WouldExceedMaxBlockCostLimit"}
+	ErrTransactionUnsupportedVersion                    = &TransactionError{Kind: "UnsupportedVersion"}
+	ErrTransactionInvalidWritableAccount                = &TransactionError{Kind: "InvalidWritableAccount"}
+	ErrTransactionWouldExceedMaxAccountCostLimit        = &TransactionError{Kind: "WouldExceedMaxAccountCostLimit"}
+	ErrTransactionWouldExceedAccountDataBlockLimit      = &TransactionError{Kind: "WouldExceedAccountDataBlockLimit"}
+	ErrTransactionTooManyAccountLocks                   = &TransactionError{Kind: "TooManyAccountLocks"}
+	ErrTransactionAddressLookupTableNotFound            = &TransactionError{Kind: "AddressLookupTableNotFound"}
+	ErrTransactionInvalidAddressLookupTableOwner        = &TransactionError{Kind: "InvalidAddressLookupTableOwner"}
+	ErrTransactionInvalidAddressLookupTableData         = &TransactionError{Kind: "InvalidAddressLookupTableData"}
+	ErrTransactionInvalidAddressLookupTableIndex        = &TransactionError{Kind: "InvalidAddressLookupTableIndex"}
+	ErrTransactionInvalidRentPayingAccount              = &TransactionError{Kind: "InvalidRentPayingAccount"}
+	ErrTransactionWouldExceedMaxVoteCostLimit           = &TransactionError{Kind: "WouldExceedMaxVoteCostLimit"}
+	ErrTransactionWouldExceedAccountDataTotalLimit      = &TransactionError{Kind: "WouldExceedAccountDataTotalLimit"}
+	ErrTransactionDuplicateInstruction                  = &TransactionError{Kind: "DuplicateInstruction"}
+	ErrTransactionInsufficientFundsForRent              = &TransactionError{Kind: "InsufficientFundsForRent"}
+	ErrTransactionMaxLoadedAccountsDataSizeExceeded     = &TransactionError{Kind: "MaxLoadedAccountsDataSizeExceeded"}
+	ErrTransactionInvalidLoadedAccountsDataSizeLimit    = &TransactionError{Kind: "InvalidLoadedAccountsDataSizeLimit"}
+	ErrTransactionResanitizationNeeded                  = &TransactionError{Kind: "ResanitizationNeeded"}
+	ErrTransactionProgramExecutionTemporarilyRestricted = &TransactionError{Kind: "ProgramExecutionTemporarilyRestricted"}
+	ErrTransactionUnbalancedTransaction                 = &TransactionError{Kind: "UnbalancedTransaction"}
+)
+
+// Errors found in InstructionError.Kind. Use them with errors.Is, or CustomInstructionError to match a program specific error code.
+var (
+	ErrInstructionGenericError                           = &InstructionError{Kind: "GenericError"}
+	ErrInstructionInvalidArgument                        = &InstructionError{Kind: "InvalidArgument"}
+	ErrInstructionInvalidInstructionData                 = &InstructionError{Kind: "InvalidInstructionData"}
+	ErrInstructionInvalidAccountData                     = &InstructionError{Kind: "InvalidAccountData"}
+	ErrInstructionAccountDataTooSmall                    = &InstructionError{Kind: "AccountDataTooSmall"}
+	ErrInstructionInsufficientFunds                      = &InstructionError{Kind: "InsufficientFunds"}
+	ErrInstructionIncorrectProgramId                     = &InstructionError{Kind: "IncorrectProgramId"}
+	ErrInstructionMissingRequiredSignature               = &InstructionError{Kind: "MissingRequiredSignature"}
+	ErrInstructionAccountAlreadyInitialized              = &InstructionError{Kind: "AccountAlreadyInitialized"}
+	ErrInstructionUninitializedAccount                   = &InstructionError{Kind: "UninitializedAccount"}
+	ErrInstructionUnbalancedInstruction                  = &InstructionError{Kind: "UnbalancedInstruction"}
+	ErrInstructionModifiedProgramId                      = &InstructionError{Kind: "ModifiedProgramId"}
+	ErrInstructionExternalAccountLamportSpend            = &InstructionError{Kind: "ExternalAccountLamportSpend"}
+	ErrInstructionExternalAccountDataModified            = &InstructionError{Kind: "ExternalAccountDataModified"}
+	ErrInstructionReadonlyLamportChange                  = &InstructionError{Kind: "ReadonlyLamportChange"}
+	ErrInstructionReadonlyDataModified                   = &InstructionError{Kind: "ReadonlyDataModified"}
+	ErrInstructionDuplicateAccountIndex                  = &InstructionError{Kind: "DuplicateAccountIndex"}
+	ErrInstructionExecutableModified                     = &InstructionError{Kind: "ExecutableModified"}
+	ErrInstructionRentEpochModified                      = &InstructionError{Kind: "RentEpochModified"}
+	ErrInstructionNotEnoughAccountKeys                   = &InstructionError{Kind: "NotEnoughAccountKeys"}
+	ErrInstructionAccountDataSizeChanged                 = &InstructionError{Kind: "AccountDataSizeChanged"}
+	ErrInstructionAccountNotExecutable                   = &InstructionError{Kind: "AccountNotExecutable"}
+	ErrInstructionAccountBorrowFailed                    = &InstructionError{Kind: "AccountBorrowFailed"}
+	ErrInstructionAccountBorrowOutstanding               = &InstructionError{Kind: "AccountBorrowOutstanding"}
+	ErrInstructionDuplicateAccountOutOfSync              = &InstructionError{Kind: "DuplicateAccountOutOfSync"}
+	ErrInstructionCustom                                 = &InstructionError{Kind: "Custom"}
+	ErrInstructionInvalidError                           = &InstructionError{Kind: "InvalidError"}
+	ErrInstructionExecutableDataModified                 = &InstructionError{Kind: "ExecutableDataModified"}
+	ErrInstructionExecutableLamportChange                = &InstructionError{Kind: "ExecutableLamportChange"}
+	ErrInstructionExecutableAccountNotRentExempt         = &InstructionError{Kind: "ExecutableAccountNotRentExempt"}
+	ErrInstructionUnsupportedProgramId                   = &InstructionError{Kind: "UnsupportedProgramId"}
+	ErrInstructionCallDepth                              = &InstructionError{Kind: "CallDepth"}
+	ErrInstructionMissingAccount                         = &InstructionError{Kind: "MissingAccount"}
+	ErrInstructionReentrancyNotAllowed                   = &InstructionError{Kind: "ReentrancyNotAllowed"}
+	ErrInstructionMaxSeedLengthExceeded                  = &InstructionError{Kind: "MaxSeedLengthExceeded"}
+	ErrInstructionInvalidSeeds                           = &InstructionError{Kind: "InvalidSeeds"}
+	ErrInstructionInvalidRealloc                         = &InstructionError{Kind: "InvalidRealloc"}
+	ErrInstructionComputationalBudgetExceeded            = &InstructionError{Kind: "ComputationalBudgetExceeded"}
+	ErrInstructionPrivilegeEscalation                    = &InstructionError{Kind: "PrivilegeEscalation"}
+	ErrInstructionProgramEnvironmentSetupFailure         = &InstructionError{Kind: "ProgramEnvironmentSetupFailure"}
+	ErrInstructionProgramFailedToComplete                = &InstructionError{Kind: "ProgramFailedToComplete"}
+	ErrInstructionProgramFailedToCompile                 = &InstructionError{Kind: "ProgramFailedToCompile"}
+	ErrInstructionImmutable                              = &InstructionError{Kind: "Immutable"}
+	ErrInstructionIncorrectAuthority                     = &InstructionError{Kind: "IncorrectAuthority"}
+	ErrInstructionBorshIoError                           = &InstructionError{Kind: "BorshIoError"}
+	ErrInstructionAccountNotRentExempt                   = &InstructionError{Kind: "AccountNotRentExempt"}
+	ErrInstructionInvalidAccountOwner                    = &InstructionError{Kind: "InvalidAccountOwner"}
+	ErrInstructionArithmeticOverflow                     = &InstructionError{Kind: "ArithmeticOverflow"}
+	ErrInstructionUnsupportedSysvar                      = &InstructionError{Kind: "UnsupportedSysvar"}
+	ErrInstructionIllegalOwner                           = &InstructionError{Kind: "IllegalOwner"}
+	ErrInstructionMaxAccountsDataAllocationsExceeded     = &InstructionError{Kind: "MaxAccountsDataAllocationsExceeded"}
+	ErrInstructionMaxAccountsExceeded                    = &InstructionError{Kind: "MaxAccountsExceeded"}
+	ErrInstructionMaxInstructionTraceLengthExceeded      = &InstructionError{Kind: "MaxInstructionTraceLengthExceeded"}
+	ErrInstructionBuiltinProgramsMustConsumeComputeUnits = &InstructionError{Kind: "BuiltinProgramsMustConsumeComputeUnits"}
+)
+
+var transactionErrorCodes = map[string]ErrorCode{
+	"AccountInUse":                          SOLANA_ERROR__TRANSACTION_ERROR__ACCOUNT_IN_USE,
+	"AccountLoadedTwice":                    SOLANA_ERROR__TRANSACTION_ERROR__ACCOUNT_LOADED_TWICE,
+	"AccountNotFound":                       SOLANA_ERROR__TRANSACTION_ERROR__ACCOUNT_NOT_FOUND,
+	"ProgramAccountNotFound":                SOLANA_ERROR__TRANSACTION_ERROR__PROGRAM_ACCOUNT_NOT_FOUND,
+	"InsufficientFundsForFee":               SOLANA_ERROR__TRANSACTION_ERROR__INSUFFICIENT_FUNDS_FOR_FEE,
+	"InvalidAccountForFee":                  SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ACCOUNT_FOR_FEE,
+	"AlreadyProcessed":                      SOLANA_ERROR__TRANSACTION_ERROR__ALREADY_PROCESSED,
+	"BlockhashNotFound":                     SOLANA_ERROR__TRANSACTION_ERROR__BLOCKHASH_NOT_FOUND,
+	"CallChainTooDeep":                      SOLANA_ERROR__TRANSACTION_ERROR__CALL_CHAIN_TOO_DEEP,
+	"MissingSignatureForFee":                SOLANA_ERROR__TRANSACTION_ERROR__MISSING_SIGNATURE_FOR_FEE,
+	"InvalidAccountIndex":                   SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ACCOUNT_INDEX,
+	"SignatureFailure":                      SOLANA_ERROR__TRANSACTION_ERROR__SIGNATURE_FAILURE,
+	"InvalidProgramForExecution":            SOLANA_ERROR__TRANSACTION_ERROR__INVALID_PROGRAM_FOR_EXECUTION,
+	"SanitizeFailure":                       SOLANA_ERROR__TRANSACTION_ERROR__SANITIZE_FAILURE,
+	"ClusterMaintenance":                    SOLANA_ERROR__TRANSACTION_ERROR__CLUSTER_MAINTENANCE,
+	"AccountBorrowOutstanding":              SOLANA_ERROR__TRANSACTION_ERROR__ACCOUNT_BORROW_OUTSTANDING,
+	"WouldExceedMaxBlockCostLimit":          SOLANA_ERROR__TRANSACTION_ERROR__WOULD_EXCEED_MAX_BLOCK_COST_LIMIT,
+	"UnsupportedVersion":                    SOLANA_ERROR__TRANSACTION_ERROR__UNSUPPORTED_VERSION,
+	"InvalidWritableAccount":                SOLANA_ERROR__TRANSACTION_ERROR__INVALID_WRITABLE_ACCOUNT,
+	"WouldExceedMaxAccountCostLimit":        SOLANA_ERROR__TRANSACTION_ERROR__WOULD_EXCEED_MAX_ACCOUNT_COST_LIMIT,
+	"WouldExceedAccountDataBlockLimit":      SOLANA_ERROR__TRANSACTION_ERROR__WOULD_EXCEED_ACCOUNT_DATA_BLOCK_LIMIT,
+	"TooManyAccountLocks":                   SOLANA_ERROR__TRANSACTION_ERROR__TOO_MANY_ACCOUNT_LOCKS,
+	"AddressLookupTableNotFound":            SOLANA_ERROR__TRANSACTION_ERROR__ADDRESS_LOOKUP_TABLE_NOT_FOUND,
+	"InvalidAddressLookupTableOwner":        SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ADDRESS_LOOKUP_TABLE_OWNER,
+	"InvalidAddressLookupTableData":         SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ADDRESS_LOOKUP_TABLE_DATA,
+	"InvalidAddressLookupTableIndex":        SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ADDRESS_LOOKUP_TABLE_INDEX,
+	"InvalidRentPayingAccount":              SOLANA_ERROR__TRANSACTION_ERROR__INVALID_RENT_PAYING_ACCOUNT,
+	"WouldExceedMaxVoteCostLimit":           SOLANA_ERROR__TRANSACTION_ERROR__WOULD_EXCEED_MAX_VOTE_COST_LIMIT,
+	"WouldExceedAccountDataTotalLimit":      SOLANA_ERROR__TRANSACTION_ERROR__WOULD_EXCEED_ACCOUNT_DATA_TOTAL_LIMIT,
+	"DuplicateInstruction":                  SOLANA_ERROR__TRANSACTION_ERROR__DUPLICATE_INSTRUCTION,
+	"InsufficientFundsForRent":              SOLANA_ERROR__TRANSACTION_ERROR__INSUFFICIENT_FUNDS_FOR_RENT,
+	"MaxLoadedAccountsDataSizeExceeded":     SOLANA_ERROR__TRANSACTION_ERROR__MAX_LOADED_ACCOUNTS_DATA_SIZE_EXCEEDED,
+	"InvalidLoadedAccountsDataSizeLimit":    SOLANA_ERROR__TRANSACTION_ERROR__INVALID_LOADED_ACCOUNTS_DATA_SIZE_LIMIT,
+	"ResanitizationNeeded":                  SOLANA_ERROR__TRANSACTION_ERROR__RESANITIZATION_NEEDED,
+	"ProgramExecutionTemporarilyRestricted": SOLANA_ERROR__TRANSACTION_ERROR__PROGRAM_EXECUTION_TEMPORARILY_RESTRICTED,
+	"UnbalancedTransaction":                 SOLANA_ERROR__TRANSACTION_ERROR__UNBALANCED_TRANSACTION,
+}
+
+var instructionErrorCodes = map[string]ErrorCode{
+	"GenericError":                           SOLANA_ERROR__INSTRUCTION_ERROR__GENERIC_ERROR,
+	"InvalidArgument":                        SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_ARGUMENT,
+	"InvalidInstructionData":                 SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_INSTRUCTION_DATA,
+	"InvalidAccountData":                     SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_ACCOUNT_DATA,
+	"AccountDataTooSmall":                    SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_DATA_TOO_SMALL,
+	"InsufficientFunds":                      SOLANA_ERROR__INSTRUCTION_ERROR__INSUFFICIENT_FUNDS,
+	"IncorrectProgramId":                     SOLANA_ERROR__INSTRUCTION_ERROR__INCORRECT_PROGRAM_ID,
+	"MissingRequiredSignature":               SOLANA_ERROR__INSTRUCTION_ERROR__MISSING_REQUIRED_SIGNATURE,
+	"AccountAlreadyInitialized":              SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_ALREADY_INITIALIZED,
+	"UninitializedAccount":                   SOLANA_ERROR__INSTRUCTION_ERROR__UNINITIALIZED_ACCOUNT,
+	"UnbalancedInstruction":                  SOLANA_ERROR__INSTRUCTION_ERROR__UNBALANCED_INSTRUCTION,
+	"ModifiedProgramId":                      SOLANA_ERROR__INSTRUCTION_ERROR__MODIFIED_PROGRAM_ID,
+	"ExternalAccountLamportSpend":            SOLANA_ERROR__INSTRUCTION_ERROR__EXTERNAL_ACCOUNT_LAMPORT_SPEND,
+	"ExternalAccountDataModified":            SOLANA_ERROR__INSTRUCTION_ERROR__EXTERNAL_ACCOUNT_DATA_MODIFIED,
+	"ReadonlyLamportChange":                  SOLANA_ERROR__INSTRUCTION_ERROR__READONLY_LAMPORT_CHANGE,
+	"ReadonlyDataModified":                   SOLANA_ERROR__INSTRUCTION_ERROR__READONLY_DATA_MODIFIED,
+	"DuplicateAccountIndex":                  SOLANA_ERROR__INSTRUCTION_ERROR__DUPLICATE_ACCOUNT_INDEX,
+	"ExecutableModified":                     SOLANA_ERROR__INSTRUCTION_ERROR__EXECUTABLE_MODIFIED,
+	"RentEpochModified":                      SOLANA_ERROR__INSTRUCTION_ERROR__RENT_EPOCH_MODIFIED,
+	"NotEnoughAccountKeys":                   SOLANA_ERROR__INSTRUCTION_ERROR__NOT_ENOUGH_ACCOUNT_KEYS,
+	"AccountDataSizeChanged":                 SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_DATA_SIZE_CHANGED,
+	"AccountNotExecutable":                   SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_NOT_EXECUTABLE,
+	"AccountBorrowFailed":                    SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_BORROW_FAILED,
+	"AccountBorrowOutstanding":               SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_BORROW_OUTSTANDING,
+	"DuplicateAccountOutOfSync":              SOLANA_ERROR__INSTRUCTION_ERROR__DUPLICATE_ACCOUNT_OUT_OF_SYNC,
+	"Custom":                                 SOLANA_ERROR__INSTRUCTION_ERROR__CUSTOM,
+	"InvalidError":                           SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_ERROR,
+	"ExecutableDataModified":                 SOLANA_ERROR__INSTRUCTION_ERROR__EXECUTABLE_DATA_MODIFIED,
+	"ExecutableLamportChange":                SOLANA_ERROR__INSTRUCTION_ERROR__EXECUTABLE_LAMPORT_CHANGE,
+	"ExecutableAccountNotRentExempt":         SOLANA_ERROR__INSTRUCTION_ERROR__EXECUTABLE_ACCOUNT_NOT_RENT_EXEMPT,
+	"UnsupportedProgramId":                   SOLANA_ERROR__INSTRUCTION_ERROR__UNSUPPORTED_PROGRAM_ID,
+	"CallDepth":                              SOLANA_ERROR__INSTRUCTION_ERROR__CALL_DEPTH,
+	"MissingAccount":                         SOLANA_ERROR__INSTRUCTION_ERROR__MISSING_ACCOUNT,
+	"ReentrancyNotAllowed":                   SOLANA_ERROR__INSTRUCTION_ERROR__REENTRANCY_NOT_ALLOWED,
+	"MaxSeedLengthExceeded":                  SOLANA_ERROR__INSTRUCTION_ERROR__MAX_SEED_LENGTH_EXCEEDED,
+	"InvalidSeeds":                           SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_SEEDS,
+	"InvalidRealloc":                         SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_REALLOC,
+	"ComputationalBudgetExceeded":            SOLANA_ERROR__INSTRUCTION_ERROR__COMPUTATIONAL_BUDGET_EXCEEDED,
+	"PrivilegeEscalation":                    SOLANA_ERROR__INSTRUCTION_ERROR__PRIVILEGE_ESCALATION,
+	"ProgramEnvironmentSetupFailure":         SOLANA_ERROR__INSTRUCTION_ERROR__PROGRAM_ENVIRONMENT_SETUP_FAILURE,
+	"ProgramFailedToComplete":                SOLANA_ERROR__INSTRUCTION_ERROR__PROGRAM_FAILED_TO_COMPLETE,
+	"ProgramFailedToCompile":                 SOLANA_ERROR__INSTRUCTION_ERROR__PROGRAM_FAILED_TO_COMPILE,
+	"Immutable":                              SOLANA_ERROR__INSTRUCTION_ERROR__IMMUTABLE,
+	"IncorrectAuthority":                     SOLANA_ERROR__INSTRUCTION_ERROR__INCORRECT_AUTHORITY,
+	"BorshIoError":                           SOLANA_ERROR__INSTRUCTION_ERROR__BORSH_IO_ERROR,
+	"AccountNotRentExempt":                   SOLANA_ERROR__INSTRUCTION_ERROR__ACCOUNT_NOT_RENT_EXEMPT,
+	"InvalidAccountOwner":                    SOLANA_ERROR__INSTRUCTION_ERROR__INVALID_ACCOUNT_OWNER,
+	"ArithmeticOverflow":                     SOLANA_ERROR__INSTRUCTION_ERROR__ARITHMETIC_OVERFLOW,
+	"UnsupportedSysvar":                      SOLANA_ERROR__INSTRUCTION_ERROR__UNSUPPORTED_SYSVAR,
+	"IllegalOwner":                           SOLANA_ERROR__INSTRUCTION_ERROR__ILLEGAL_OWNER,
+	"MaxAccountsDataAllocationsExceeded":     SOLANA_ERROR__INSTRUCTION_ERROR__MAX_ACCOUNTS_DATA_ALLOCATIONS_EXCEEDED,
+	"MaxAccountsExceeded":                    SOLANA_ERROR__INSTRUCTION_ERROR__MAX_ACCOUNTS_EXCEEDED,
+	"MaxInstructionTraceLengthExceeded":      SOLANA_ERROR__INSTRUCTION_ERROR__MAX_INSTRUCTION_TRACE_LENGTH_EXCEEDED,
+	"BuiltinProgramsMustConsumeComputeUnits": SOLANA_ERROR__INSTRUCTION_ERROR__BUILTIN_PROGRAMS_MUST_CONSUME_COMPUTE_UNITS,
+}
+
+// Returns an error matching any instruction that failed with the given program specific error code.
+func CustomInstructionError(code uint32) *InstructionError {
+	return &InstructionError{Kind: "Custom", Custom: &code}
+}
+
+// Returns the SOLANA_ERROR__TRANSACTION_ERROR__* code of the error, or the SOLANA_ERROR__INSTRUCTION_ERROR__* code of the failed instruction.
+func (e *TransactionError) Code() ErrorCode {
+	if code, ok := transactionErrorCodes[e.Kind]; ok {
+		return code
+	}
+	if e.InstructionError != nil {
+		return e.InstructionError.Code()
+	}
+	return SOLANA_ERROR__TRANSACTION_ERROR__UNKNOWN
+}
+
+func (e *TransactionError) Error() string {
+	switch {
+	case e.InstructionError != nil:
+		return fmt.Sprintf("transaction failed: %v", e.InstructionError)
+	case e.Index != nil:
+		return fmt.Sprintf("transaction failed: %s (index %d)", e.Kind, *e.Index)
+	}
+	return fmt.Sprintf("transaction failed: %s", e.Kind)
+}
+
+// Matches a TransactionError of the same kind, or a SolanaError with the same code.
+func (e *TransactionError) Is(target error) bool {
+	switch t := target.(type) {
+	case *TransactionError:
+		return t.Kind == e.Kind && (t.InstructionError == nil || (e.InstructionError != nil && e.InstructionError.Is(t.InstructionError)))
+	case *SolanaError:
+		return t.Code == e.Code()
+	}
+	return false
+}
+
+func (e *TransactionError) Unwrap() error {
+	if e.InstructionError == nil {
+		return nil
+	}
+	return e.InstructionError
+}
+
+// Decodes both the unit variants, e.g. "BlockhashNotFound", and the variants carrying data, e.g. {"InstructionError":[0,{"Custom":1}]}.
+func (e *TransactionError) UnmarshalJSON(data []byte) error {
+	var kind string
+	if err := json.Unmarshal(data, &kind); err == nil {
+		*e = TransactionError{Kind: kind}
+		return nil
+	}
+
+	var variant map[string]json.RawMessage
+	if err := json.Unmarshal(data, &variant); err != nil {
+		return err
+	}
+	if len(variant) != 1 {
+		return fmt.Errorf("invalid transaction error: %s", data)
+	}
+	for kind, value := range variant {
+		*e = TransactionError{Kind: kind}
+		switch kind {
+		case "InstructionError":
+			e.InstructionError = &InstructionError{}
+			if err := json.Unmarshal(value, e.InstructionError); err != nil {
+				return err
+			}
+		case "DuplicateInstruction":
+			e.Index = new(int)
+			if err := json.Unmarshal(value, e.Index); err != nil {
+				return err
+			}
+		case "InsufficientFundsForRent", "ProgramExecutionTemporarilyRestricted":
+			var account struct {
+				AccountIndex int `json:"account_index"`
+			}
+			if err := json.Unmarshal(value, &account); err != nil {
+				return err
+			}
+			e.Index = &account.AccountIndex
+		}
+	}
+	return nil
+}
+
+func (e *TransactionError) MarshalJSON() ([]byte, error) {
+	switch {
+	case e.InstructionError != nil:
+		return json.Marshal(map[string]*InstructionError{e.Kind: e.InstructionError})
+	case e.Index != nil && e.Kind == "DuplicateInstruction":
+		return json.Marshal(map[string]int{e.Kind: *e.Index})
+	case e.Index != nil:
+		return json.Marshal(map[string]map[string]int{e.Kind: {"account_index": *e.Index}})
+	}
+	return json.Marshal(e.Kind)
+}
+
+// Returns the SOLANA_ERROR__INSTRUCTION_ERROR__* code of the error.
+func (e *InstructionError) Code() ErrorCode {
+	if code, ok := instructionErrorCodes[e.Kind]; ok {
+		return code
+	}
+	return SOLANA_ERROR__INSTRUCTION_ERROR__UNKNOWN
+}
+
+func (e *InstructionError) Error() string {
+	switch {
+	case e.Custom != nil:
+		return fmt.Sprintf("instruction %d failed: custom program error: %#x", e.Index, *e.Custom)
+	case e.Message != nil:
+		return fmt.Sprintf("instruction %d failed: %s: %s", e.Index, e.Kind, *e.Message)
+	}
+	return fmt.Sprintf("instruction %d failed: %s", e.Index, e.Kind)
+}
+
+// Matches an InstructionError of the same kind regardless of the instruction index, or a SolanaError with the same code.
+// If target has a custom error code it has to match as well.
+func (e *InstructionError) Is(target error) bool {
+	switch t := target.(type) {
+	case *InstructionError:
+		return t.Kind == e.Kind && (t.Custom == nil || (e.Custom != nil && *t.Custom == *e.Custom))
+	case *SolanaError:
+		return t.Code == e.Code()
+	}
+	return false
+}
+
+// Decodes the [index, "Kind"] and [index, {"Kind": value}] tuples returned by the RPC.
+func (e *InstructionError) UnmarshalJSON(data []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return fmt.Errorf("invalid instruction error: %s", data)
+	}
+	*e = InstructionError{}
+	if err := json.Unmarshal(tuple[0], &e.Index); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tuple[1], &e.Kind); err == nil {
+		return nil
+	}
+
+	var variant map[string]json.RawMessage
+	if err := json.Unmarshal(tuple[1], &variant); err != nil {
+		return err
+	}
+	if len(variant) != 1 {
+		return fmt.Errorf("invalid instruction error: %s", data)
+	}
+	for kind, value := range variant {
+		e.Kind = kind
+		switch kind {
+		case "Custom":
+			e.Custom = new(uint32)
+			if err := json.Unmarshal(value, e.Custom); err != nil {
+				return err
+			}
+		case "BorshIoError":
+			e.Message = new(string)
+			if err := json.Unmarshal(value, e.Message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *InstructionError) MarshalJSON() ([]byte, error) {
+	var kind any = e.Kind
+	switch {
+	case e.Custom != nil:
+		kind = map[string]uint32{e.Kind: *e.Custom}
+	case e.Message != nil:
+		kind = map[string]string{e.Kind: *e.Message}
+	}
+	return json.Marshal([]any{e.Index, kind})
+}
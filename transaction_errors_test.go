@@ -0,0 +1,95 @@
+package solana
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestTransactionErrorJSON(t *testing.T) {
+	tests := []struct {
+		data  string
+		match error
+	}{
+		{`"BlockhashNotFound"`, ErrTransactionBlockhashNotFound},
+		{`{"InstructionError":[1,"InvalidArgument"]}`, ErrInstructionInvalidArgument},
+		{`{"InstructionError":[0,{"Custom":6001}]}`, CustomInstructionError(6001)},
+		{`{"InstructionError":[2,{"BorshIoError":"Unknown"}]}`, ErrInstructionBorshIoError},
+		{`{"DuplicateInstruction":3}`, ErrTransactionDuplicateInstruction},
+		{`{"InsufficientFundsForRent":{"account_index":4}}`, ErrTransactionInsufficientFundsForRent},
+	}
+	for _, test := range tests {
+		var txErr TransactionError
+		if err := json.Unmarshal([]byte(test.data), &txErr); err != nil {
+			t.Fatal(err)
+		}
+		if !errors.Is(&txErr, test.match) {
+			t.Fatal("Expected error to match", test.data, test.match)
+		}
+
+		data, err := json.Marshal(&txErr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != test.data {
+			t.Fatal("Unexpected encoding", string(data), test.data)
+		}
+	}
+}
+
+func TestTransactionErrorIs(t *testing.T) {
+	var txErr *TransactionError
+	if err := json.Unmarshal([]byte(`{"InstructionError":[0,{"Custom":1}]}`), &txErr); err != nil {
+		t.Fatal(err)
+	}
+	if errors.Is(txErr, CustomInstructionError(2)) {
+		t.Fatal("Expected different custom error codes not to match")
+	}
+	if errors.Is(txErr, ErrTransactionBlockhashNotFound) {
+		t.Fatal("Expected different kinds not to match")
+	}
+	if !errors.Is(txErr, ErrTransactionInstructionError) {
+		t.Fatal("Expected instruction error to match its transaction error kind")
+	}
+	if !errors.Is(txErr, NewSolanaError(SOLANA_ERROR__INSTRUCTION_ERROR__CUSTOM, "")) {
+		t.Fatal("Expected instruction error to match its error code")
+	}
+
+	var instructionErr *InstructionError
+	if !errors.As(txErr, &instructionErr) || instructionErr.Index != 0 || *instructionErr.Custom != 1 {
+		t.Fatal("Unexpected instruction error", instructionErr)
+	}
+	if txErr.Error() != "transaction failed: instruction 0 failed: custom program error: 0x1" {
+		t.Fatal("Unexpected error message", txErr.Error())
+	}
+}
+
+func TestSignatureStatusErr(t *testing.T) {
+	var status SignatureStatus
+	if err := json.Unmarshal([]byte(`{"slot":1,"confirmations":null,"err":"AccountNotFound","confirmationStatus":"finalized","status":{"Err":"AccountNotFound"}}`), &status); err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(status.Err, ErrTransactionAccountNotFound) || status.Err.Code() != SOLANA_ERROR__TRANSACTION_ERROR__ACCOUNT_NOT_FOUND {
+		t.Fatal("Unexpected status error", status.Err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"slot":1,"confirmations":null,"err":null,"confirmationStatus":"finalized","status":{"Ok":null}}`), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.Err != nil {
+		t.Fatal("Expected no error", status.Err)
+	}
+}
+
+func TestSolanaError(t *testing.T) {
+	err := NewSolanaError(SOLANA_ERROR__JSON_RPC__SERVER_ERROR_NODE_UNHEALTHY, "Node is behind by 42 slots")
+	if !errors.Is(err, ErrRpcNodeUnhealthy) || errors.Is(err, ErrRpcBlockNotAvailable) {
+		t.Fatal("Unexpected match")
+	}
+	if err.Error() != "Node is behind by 42 slots (SOLANA_ERROR__JSON_RPC__SERVER_ERROR_NODE_UNHEALTHY)" {
+		t.Fatal("Unexpected error message", err.Error())
+	}
+	if ErrorCode(-1).String() != "SOLANA_ERROR__UNKNOWN(-1)" {
+		t.Fatal("Unexpected name for unknown code", ErrorCode(-1).String())
+	}
+}
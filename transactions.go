@@ -1,8 +1,11 @@
 package solana
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"slices"
+	"strconv"
 
 	"github.com/mr-tron/base58"
 )
@@ -12,15 +15,28 @@ func SolInLamports(lamports uint) uint {
 }
 
 type Transaction struct {
-	Signatures []string `json:"signatures"`
+	Signatures []string `json:"signatures"` //One slot per required signer, in the order of the account keys. Empty until the signer signs.
 	Message    Message  `json:"message"`
 }
 
 type Message struct {
-	Instructions    []Instruction `json:"instructions"`
-	RecentBlockhash string        `json:"recentBlockhash"`
+	FeePayer        Pubkey             `json:"feePayer"` //Account paying the fees, placed first in the account keys. Defaults to the first signer of the instructions.
+	Instructions    []Instruction      `json:"instructions"`
+	RecentBlockhash string             `json:"recentBlockhash"`
+	Version         TransactionVersion `json:"version"` //Format the message is serialized in, legacy unless set or lookup tables are given to Serialize
 }
 
+// Version of a message's wire format. The zero value is a legacy message.
+type TransactionVersion uint8
+
+const (
+	TransactionVersionLegacy TransactionVersion = iota //Legacy message, without a version prefix
+	TransactionVersion0                                //Version 0 message, which can load accounts from address lookup tables
+)
+
+// Bit set in the first byte of a versioned message. Legacy messages start with the number of required signatures, which never has it set.
+const messageVersionPrefix = 0x80
+
 type Instruction struct {
 	Accounts  []AccountMeta `json:"accounts"`
 	Data      []byte        `json:"data"`
@@ -35,14 +51,29 @@ type AccountMeta struct {
 
 type RawTransaction struct {
 	Message    RawMessage `json:"message"`
-	Signatures []string   `json:"signatures"`
+	Signatures []string   `json:"signatures"` //One slot per required signer, in the order of the account keys. Empty until the signer signs.
 }
 
 type RawMessage struct {
-	AccountKeys     []Pubkey         `json:"accountKeys"`
-	Header          MessageHeader    `json:"header"`
-	Instructions    []RawInstruction `json:"instructions"`
-	RecentBlockhash string           `json:"recentBlockhash"`
+	Version             TransactionVersion          `json:"version"`
+	AccountKeys         []Pubkey                    `json:"accountKeys"` //Static account keys. Instructions of a version 0 message also index the addresses loaded from lookup tables, which follow the static keys.
+	Header              MessageHeader               `json:"header"`
+	Instructions        []RawInstruction            `json:"instructions"`
+	RecentBlockhash     string                      `json:"recentBlockhash"`
+	AddressTableLookups []MessageAddressTableLookup `json:"addressTableLookups,omitempty"` //Accounts loaded from address lookup tables, only in version 0 messages
+}
+
+// Accounts a version 0 message loads from an address lookup table.
+type MessageAddressTableLookup struct {
+	AccountKey      Pubkey `json:"accountKey"`      //Address of the lookup table
+	WritableIndexes []int  `json:"writableIndexes"` //Indexes of the writable accounts loaded from the table
+	ReadonlyIndexes []int  `json:"readonlyIndexes"` //Indexes of the readonly accounts loaded from the table
+}
+
+// An address lookup table, as stored on chain by the address lookup table program.
+type AddressLookupTable struct {
+	Key       Pubkey   //Address of the lookup table account
+	Addresses []Pubkey //Addresses stored in the table
 }
 
 type MessageHeader struct {
@@ -78,59 +109,99 @@ func ParseTransactionData(data []byte) (RawTransaction, error) {
 	if err != nil {
 		return RawTransaction{}, err
 	}
+	message, err := ParseMessageData(messageData)
+	if err != nil {
+		return RawTransaction{}, err
+	}
+	return RawTransaction{Signatures: signatures, Message: message}, nil
+}
+
+// Parses a legacy or version 0 message from its wire format.
+func ParseMessageData(data []byte) (RawMessage, error) {
+	version, messageData, err := readMessageVersion(data)
+	if err != nil {
+		return RawMessage{}, err
+	}
 	if len(messageData) < 3 {
-		return RawTransaction{}, errors.New("not enough data to read message header")
+		return RawMessage{}, errors.New("not enough data to read message header")
 	}
 	numRequiredSignatures, numReadonlySignedAccounts, numReadonlyUnsignedAccounts, messageData, err := readMessageHeader(messageData)
 	if err != nil {
-		return RawTransaction{}, err
+		return RawMessage{}, err
 	}
 
 	accounts, messageData, err := getAccounts(messageData)
 	if err != nil {
-		return RawTransaction{}, err
+		return RawMessage{}, err
 	}
 
 	recentBlockhash, instructionsData, err := getRecentBlockhash(messageData)
 	if err != nil {
-		return RawTransaction{}, err
+		return RawMessage{}, err
 	}
 
-	instructions, err := parseInstructions(instructionsData)
+	instructions, lookupsData, err := parseInstructions(instructionsData)
 	if err != nil {
-		return RawTransaction{}, err
+		return RawMessage{}, err
 	}
 
-	return RawTransaction{
-		Signatures: signatures,
-		Message: RawMessage{
-			AccountKeys: accounts,
-			Header: MessageHeader{
-				NumRequiredSignatures:       numRequiredSignatures,
-				NumReadonlySignedAccounts:   numReadonlySignedAccounts,
-				NumReadonlyUnsignedAccounts: numReadonlyUnsignedAccounts,
-			},
-			Instructions:    instructions,
-			RecentBlockhash: recentBlockhash,
+	var lookups []MessageAddressTableLookup
+	remainingData := lookupsData
+	if version == TransactionVersion0 {
+		if lookups, remainingData, err = parseAddressTableLookups(lookupsData); err != nil {
+			return RawMessage{}, err
+		}
+	}
+	//The runtime refuses transactions with trailing bytes, so they are not silently dropped
+	if len(remainingData) > 0 {
+		return RawMessage{}, fmt.Errorf("unexpected %d bytes after the message", len(remainingData))
+	}
+
+	return RawMessage{
+		Version:     version,
+		AccountKeys: accounts,
+		Header: MessageHeader{
+			NumRequiredSignatures:       numRequiredSignatures,
+			NumReadonlySignedAccounts:   numReadonlySignedAccounts,
+			NumReadonlyUnsignedAccounts: numReadonlyUnsignedAccounts,
 		},
+		Instructions:        instructions,
+		RecentBlockhash:     recentBlockhash,
+		AddressTableLookups: lookups,
 	}, nil
 }
 
-func getSignatures(data []byte) ([]string, []byte, error) {
+func readMessageVersion(data []byte) (TransactionVersion, []byte, error) {
 	if len(data) < 1 {
-		return nil, nil, errors.New("not enough data to read number of signatures")
+		return 0, nil, errors.New("not enough data to read message version")
+	}
+	if data[0]&messageVersionPrefix == 0 {
+		return TransactionVersionLegacy, data, nil
+	}
+	if version := data[0] &^ messageVersionPrefix; version != 0 {
+		return 0, nil, fmt.Errorf("unsupported message version %d", version)
+	}
+	return TransactionVersion0, data[1:], nil
+}
+
+func getSignatures(data []byte) ([]string, []byte, error) {
+	numSignatures, data, err := readShortVecLength(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read number of signatures: %w", err)
+	}
+	if len(data) < numSignatures*signatureLength {
+		return nil, nil, errors.New("not enough data to read signatures")
 	}
 
-	numSignatures := int(data[0])
 	signatures := make([]string, numSignatures)
 	for i := 0; i < numSignatures; i++ {
-		if len(data) < 1+(i+1)*64 {
-			return nil, nil, errors.New("not enough data to read signature")
+		signatureData := data[i*signatureLength : (i+1)*signatureLength]
+		//Slots of signatures not yet added are zeroed
+		if !isEmptySignature(signatureData) {
+			signatures[i] = base58.Encode(signatureData)
 		}
-		signatureData := data[1+i*64 : 1+(i+1)*64]
-		signatures[i] = base58.Encode(signatureData)
 	}
-	remainingData := data[1+numSignatures*64:]
+	remainingData := data[numSignatures*signatureLength:]
 	return signatures, remainingData, nil
 }
 
@@ -146,18 +217,16 @@ func readMessageHeader(data []byte) (int, int, int, []byte, error) {
 }
 
 func getAccounts(data []byte) ([]Pubkey, []byte, error) {
-	if len(data) < 1 {
-		return nil, nil, errors.New("not enough data to read number of accounts")
+	totalNumAccounts, data, err := readShortVecLength(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read number of accounts: %w", err)
 	}
-
-	totalNumAccounts := data[0]
-	data = data[1:]
-	if len(data) < int(totalNumAccounts)*32 {
+	if len(data) < totalNumAccounts*32 {
 		return nil, nil, errors.New("not enough data to read accounts")
 	}
 
 	accounts := make([]Pubkey, totalNumAccounts)
-	for i := 0; i < int(totalNumAccounts); i++ {
+	for i := 0; i < totalNumAccounts; i++ {
 		accountData := data[i*32 : (i+1)*32]
 		pubkey, err := ParsePubkeyBytes(accountData)
 		if err != nil {
@@ -165,7 +234,7 @@ func getAccounts(data []byte) ([]Pubkey, []byte, error) {
 		}
 		accounts[i] = pubkey
 	}
-	remainingData := data[int(totalNumAccounts)*32:]
+	remainingData := data[totalNumAccounts*32:]
 	return accounts, remainingData, nil
 }
 
@@ -179,23 +248,26 @@ func getRecentBlockhash(data []byte) (string, []byte, error) {
 	return blockhash, remainingData, nil
 }
 
-func parseInstructions(data []byte) ([]RawInstruction, error) {
-	if len(data) < 1 {
-		return nil, errors.New("not enough data to read number of instructions")
+func parseInstructions(data []byte) ([]RawInstruction, []byte, error) {
+	numInstructions, data, err := readShortVecLength(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read number of instructions: %w", err)
 	}
 
-	numInstructions := int(data[0])
-	data = data[1:]
+	//Every instruction takes at least 3 bytes, which bounds the allocation for malformed data
+	if len(data) < numInstructions*3 {
+		return nil, nil, errors.New("not enough data to read instructions")
+	}
 	instructions := make([]RawInstruction, numInstructions)
 	for i := 0; i < numInstructions; i++ {
 		instruction, remainingData, err := parseInstruction(data)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		instructions[i] = instruction
 		data = remainingData
 	}
-	return instructions, nil
+	return instructions, data, nil
 }
 
 func parseInstruction(data []byte) (RawInstruction, []byte, error) {
@@ -205,27 +277,15 @@ func parseInstruction(data []byte) (RawInstruction, []byte, error) {
 	programIDIndex := int(data[0])
 	data = data[1:]
 
-	if len(data) < 1 {
-		return RawInstruction{}, nil, errors.New("not enough data to read accounts")
-	}
-	numAccounts := int(data[0])
-	data = data[1:]
-
-	if len(data) < numAccounts {
-		return RawInstruction{}, nil, errors.New("not enough data to read accounts")
-	}
-	accounts := make([]int, numAccounts)
-	for i := 0; i < numAccounts; i++ {
-		accounts[i] = int(data[i])
+	accounts, data, err := parseIndexes(data)
+	if err != nil {
+		return RawInstruction{}, nil, fmt.Errorf("failed to read instruction accounts: %w", err)
 	}
-	data = data[numAccounts:]
 
-	if len(data) < 1 {
-		return RawInstruction{}, nil, errors.New("not enough data to read data length")
+	dataLength, data, err := readShortVecLength(data)
+	if err != nil {
+		return RawInstruction{}, nil, fmt.Errorf("failed to read data length: %w", err)
 	}
-	dataLength := int(data[0])
-	data = data[1:]
-
 	if len(data) < dataLength {
 		return RawInstruction{}, nil, errors.New("not enough data to read data")
 	}
@@ -239,6 +299,55 @@ func parseInstruction(data []byte) (RawInstruction, []byte, error) {
 	}, data, nil
 }
 
+func parseAddressTableLookups(data []byte) ([]MessageAddressTableLookup, []byte, error) {
+	numLookups, data, err := readShortVecLength(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read number of address table lookups: %w", err)
+	}
+
+	//Every lookup takes at least 34 bytes, which bounds the allocation for malformed data
+	if len(data) < numLookups*34 {
+		return nil, nil, errors.New("not enough data to read address table lookups")
+	}
+	lookups := make([]MessageAddressTableLookup, numLookups)
+	for i := 0; i < numLookups; i++ {
+		if len(data) < 32 {
+			return nil, nil, errors.New("not enough data to read lookup table address")
+		}
+		accountKey, err := ParsePubkeyBytes(data[:32])
+		if err != nil {
+			return nil, nil, err
+		}
+		writableIndexes, remainingData, err := parseIndexes(data[32:])
+		if err != nil {
+			return nil, nil, err
+		}
+		readonlyIndexes, remainingData, err := parseIndexes(remainingData)
+		if err != nil {
+			return nil, nil, err
+		}
+		lookups[i] = MessageAddressTableLookup{AccountKey: accountKey, WritableIndexes: writableIndexes, ReadonlyIndexes: readonlyIndexes}
+		data = remainingData
+	}
+	return lookups, data, nil
+}
+
+// Reads a compact-u16 prefixed array of u8 account indexes.
+func parseIndexes(data []byte) ([]int, []byte, error) {
+	numIndexes, data, err := readShortVecLength(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) < numIndexes {
+		return nil, nil, errors.New("not enough data to read indexes")
+	}
+	indexes := make([]int, numIndexes)
+	for i := 0; i < numIndexes; i++ {
+		indexes[i] = int(data[i])
+	}
+	return indexes, data[numIndexes:], nil
+}
+
 func (transaction RawTransaction) Bytes() ([]byte, error) {
 	signaturesData, err := getSignaturesData(transaction.Signatures)
 	if err != nil {
@@ -253,11 +362,22 @@ func (transaction RawTransaction) Bytes() ([]byte, error) {
 }
 
 func (message RawMessage) Bytes() ([]byte, error) {
-	messageHeaderData := []byte{
+	var messageHeaderData []byte
+	switch message.Version {
+	case TransactionVersionLegacy:
+		if len(message.AddressTableLookups) > 0 {
+			return nil, errors.New("legacy messages cannot load accounts from address lookup tables")
+		}
+	case TransactionVersion0:
+		messageHeaderData = append(messageHeaderData, messageVersionPrefix)
+	default:
+		return nil, fmt.Errorf("unsupported message version %s", message.Version)
+	}
+	messageHeaderData = append(messageHeaderData,
 		byte(message.Header.NumRequiredSignatures),
 		byte(message.Header.NumReadonlySignedAccounts),
 		byte(message.Header.NumReadonlyUnsignedAccounts),
-	}
+	)
 
 	accountsData, err := getAccountsData(message.AccountKeys)
 	if err != nil {
@@ -274,23 +394,44 @@ func (message RawMessage) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
-	return append(append(append(messageHeaderData, accountsData...), recentBlockhashData...), instructionsData...), nil
+	messageData := append(append(append(messageHeaderData, accountsData...), recentBlockhashData...), instructionsData...)
+	if message.Version == TransactionVersion0 {
+		lookupsData, err := getAddressTableLookupsData(message.AddressTableLookups)
+		if err != nil {
+			return nil, err
+		}
+		messageData = append(messageData, lookupsData...)
+	}
+	return messageData, nil
 }
 
 func getSignaturesData(signatures []string) ([]byte, error) {
-	signaturesData := []byte{byte(len(signatures))}
+	signaturesData, err := appendShortVecLength(nil, len(signatures))
+	if err != nil {
+		return nil, err
+	}
 	for _, signature := range signatures {
+		if signature == "" {
+			signaturesData = append(signaturesData, make([]byte, signatureLength)...)
+			continue
+		}
 		signatureData, err := base58.Decode(signature)
 		if err != nil {
 			return nil, err
 		}
+		if len(signatureData) != signatureLength {
+			return nil, fmt.Errorf("invalid signature length %d", len(signatureData))
+		}
 		signaturesData = append(signaturesData, signatureData...)
 	}
 	return signaturesData, nil
 }
 
 func getAccountsData(accounts []Pubkey) ([]byte, error) {
-	accountsData := []byte{byte(len(accounts))}
+	accountsData, err := appendShortVecLength(nil, len(accounts))
+	if err != nil {
+		return nil, err
+	}
 	for _, account := range accounts {
 		accountsData = append(accountsData, account.Bytes()...)
 	}
@@ -302,11 +443,17 @@ func getRecentBlockhashData(recentBlockhash string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(blockhashData) != 32 {
+		return nil, fmt.Errorf("invalid recent blockhash length %d", len(blockhashData))
+	}
 	return blockhashData, nil
 }
 
 func getInstructionsData(instructions []RawInstruction) ([]byte, error) {
-	instructionsData := []byte{byte(len(instructions))}
+	instructionsData, err := appendShortVecLength(nil, len(instructions))
+	if err != nil {
+		return nil, err
+	}
 	for _, instruction := range instructions {
 		instructionData, err := getInstructionData(instruction)
 		if err != nil {
@@ -318,138 +465,82 @@ func getInstructionsData(instructions []RawInstruction) ([]byte, error) {
 }
 
 func getInstructionData(instruction RawInstruction) ([]byte, error) {
-	instructionData := []byte{
-		byte(instruction.ProgramIDIndex),
-		byte(len(instruction.Accounts)),
+	if instruction.ProgramIDIndex < 0 || instruction.ProgramIDIndex > 255 {
+		return nil, fmt.Errorf("program id index %d does not fit in a byte", instruction.ProgramIDIndex)
 	}
-	for _, account := range instruction.Accounts {
-		instructionData = append(instructionData, byte(account))
+	instructionData, err := getIndexesData([]byte{byte(instruction.ProgramIDIndex)}, instruction.Accounts)
+	if err != nil {
+		return nil, err
+	}
+	instructionData, err = appendShortVecLength(instructionData, len(instruction.Data))
+	if err != nil {
+		return nil, err
 	}
-	instructionData = append(instructionData, byte(len(instruction.Data)))
 	instructionData = append(instructionData, instruction.Data...)
 	return instructionData, nil
 }
 
-func (tx Transaction) Serialize() RawTransaction {
-	rawTransaction := RawTransaction{
-		Signatures: tx.Signatures,
-	}
-
-	accountKeys, header := populateAccountKeys(tx.Message)
-
-	rawTransaction.Message = RawMessage{
-		AccountKeys:     accountKeys,
-		Header:          header,
-		Instructions:    getInstructions(tx.Message.Instructions, accountKeys),
-		RecentBlockhash: tx.Message.RecentBlockhash,
-	}
-
-	return rawTransaction
-}
-
-func populateAccountKeys(msg Message) ([]Pubkey, MessageHeader) {
-	var accountKeys []Pubkey
-	var readOnlySigned int
-	var readOnlyUnsigned int
-	var signers int
-
-	//First we add the signers + writable accounts
-	for _, instruction := range msg.Instructions {
-		for _, account := range instruction.Accounts {
-			if account.Signer && account.Writable {
-				accountKeys = append(accountKeys, account.Pubkey)
-				signers++
-			}
-		}
-	}
-	//Then we add the signers + readonly accounts
-	for _, instruction := range msg.Instructions {
-		for _, account := range instruction.Accounts {
-			if account.Signer && !account.Writable {
-				accountKeys = append(accountKeys, account.Pubkey)
-				signers++
-				readOnlySigned++
-			}
-		}
-	}
-	//Then we add the writable accounts
-	for _, instruction := range msg.Instructions {
-		for _, account := range instruction.Accounts {
-			if !account.Signer && account.Writable {
-				accountKeys = append(accountKeys, account.Pubkey)
-			}
-		}
-	}
-	//Finally we add the readonly accounts
-	for _, instruction := range msg.Instructions {
-		for _, account := range instruction.Accounts {
-			if !account.Signer && !account.Writable {
-				accountKeys = append(accountKeys, account.Pubkey)
-				readOnlyUnsigned++
-			}
-		}
+func getAddressTableLookupsData(lookups []MessageAddressTableLookup) ([]byte, error) {
+	lookupsData, err := appendShortVecLength(nil, len(lookups))
+	if err != nil {
+		return nil, err
 	}
-	for _, instruction := range msg.Instructions {
-		if !slices.ContainsFunc(accountKeys, func(pubkey Pubkey) bool {
-			return pubkey.String() == instruction.ProgramID.String()
-		}) {
-			accountKeys = append(accountKeys, instruction.ProgramID)
+	for _, lookup := range lookups {
+		lookupsData = append(lookupsData, lookup.AccountKey.Bytes()...)
+		if lookupsData, err = getIndexesData(lookupsData, lookup.WritableIndexes); err != nil {
+			return nil, err
 		}
-	}
-	return accountKeys, MessageHeader{
-		NumReadonlySignedAccounts:   readOnlySigned,
-		NumReadonlyUnsignedAccounts: readOnlyUnsigned,
-		NumRequiredSignatures:       signers,
-	}
-}
-
-func getInstructions(instructions []Instruction, accountKeys []Pubkey) []RawInstruction {
-	rawInstructions := make([]RawInstruction, len(instructions))
-	for i, instruction := range instructions {
-		rawInstructions[i] = RawInstruction{
-			Accounts:       getAccountIndices(instruction.Accounts, accountKeys),
-			Data:           instruction.Data,
-			ProgramIDIndex: getProgramIndex(instruction.ProgramID, accountKeys),
+		if lookupsData, err = getIndexesData(lookupsData, lookup.ReadonlyIndexes); err != nil {
+			return nil, err
 		}
 	}
-	return rawInstructions
+	return lookupsData, nil
 }
 
-func getAccountIndices(accounts []AccountMeta, accountKeys []Pubkey) []int {
-	indices := make([]int, len(accounts))
-	accountKeysStr := make([]string, len(accountKeys))
-	for i, key := range accountKeys {
-		accountKeysStr[i] = key.String()
+// Appends a compact-u16 prefixed array of u8 account indexes.
+func getIndexesData(data []byte, indexes []int) ([]byte, error) {
+	data, err := appendShortVecLength(data, len(indexes))
+	if err != nil {
+		return nil, err
 	}
-	for i, account := range accounts {
-		indices[i] = slices.Index(accountKeysStr, account.Pubkey.String())
+	for _, index := range indexes {
+		if index < 0 || index > 255 {
+			return nil, fmt.Errorf("account index %d does not fit in a byte", index)
+		}
+		data = append(data, byte(index))
 	}
-	return indices
+	return data, nil
 }
 
-func getProgramIndex(programID Pubkey, accountKeys []Pubkey) int {
-	accountKeysStr := make([]string, len(accountKeys))
-	for i, key := range accountKeys {
-		accountKeysStr[i] = key.String()
+// Compiles the transaction into its raw form, the way the Rust SDK does: the fee payer comes first, every account appears once with the union of its privileges, and accounts are ordered writable signers, readonly signers, writable and readonly non-signers, each group sorted by pubkey bytes.
+// Given lookup tables, writable and readonly non-signer accounts found in them are loaded from the tables in a version 0 message instead of being listed in AccountKeys. Signers and program IDs are never loaded from lookup tables.
+func (tx Transaction) Serialize(lookupTables ...AddressLookupTable) (RawTransaction, error) {
+	message, err := compileMessage(tx.Message, lookupTables)
+	if err != nil {
+		return RawTransaction{}, err
 	}
-	return slices.Index(accountKeysStr, programID.String())
+	return RawTransaction{Signatures: tx.Signatures, Message: message}, nil
 }
 
-func (rawTx RawTransaction) Transaction() (Transaction, error) {
+// Returns the transaction with its instructions resolved to account keys. A version 0 message loading accounts from lookup tables needs the loaded addresses, as returned in TransactionMeta.LoadedAddresses or by RawMessage.LoadAddresses.
+func (rawTx RawTransaction) Transaction(loadedAddresses ...LoadedAddresses) (Transaction, error) {
 	var instructions []Instruction
 
-	instructionAccounts := getInstructionAccounts(rawTx.Message.AccountKeys, rawTx.Message.Header)
+	instructionAccounts, err := getInstructionAccounts(rawTx.Message, loadedAddresses)
+	if err != nil {
+		return Transaction{}, err
+	}
 
 	for _, rawInstruction := range rawTx.Message.Instructions {
-		if len(rawTx.Message.AccountKeys) <= rawInstruction.ProgramIDIndex {
+		if len(instructionAccounts) <= rawInstruction.ProgramIDIndex {
 			return Transaction{}, errors.New("invalid program ID index, not enough account keys")
 		}
 		instruction := Instruction{Data: rawInstruction.Data,
-			ProgramID: rawTx.Message.AccountKeys[rawInstruction.ProgramIDIndex],
+			ProgramID: instructionAccounts[rawInstruction.ProgramIDIndex].Pubkey,
 			Accounts:  make([]AccountMeta, len(rawInstruction.Accounts)),
 		}
 		for i, accountIndex := range rawInstruction.Accounts {
-			if len(rawTx.Message.AccountKeys) <= accountIndex {
+			if len(instructionAccounts) <= accountIndex {
 				return Transaction{}, errors.New("invalid account index, not enough account keys")
 			}
 			instruction.Accounts[i] = instructionAccounts[accountIndex]
@@ -458,16 +549,25 @@ func (rawTx RawTransaction) Transaction() (Transaction, error) {
 		instructions = append(instructions, instruction)
 	}
 
+	var feePayer Pubkey
+	if rawTx.Message.Header.NumRequiredSignatures > 0 && len(rawTx.Message.AccountKeys) > 0 {
+		feePayer = rawTx.Message.AccountKeys[0]
+	}
+
 	return Transaction{
 		Signatures: rawTx.Signatures,
 		Message: Message{
+			FeePayer:        feePayer,
 			Instructions:    instructions,
 			RecentBlockhash: rawTx.Message.RecentBlockhash,
+			Version:         rawTx.Message.Version,
 		},
 	}, nil
 }
 
-func getInstructionAccounts(accountKeys []Pubkey, header MessageHeader) []AccountMeta {
+func getInstructionAccounts(message RawMessage, loadedAddresses []LoadedAddresses) ([]AccountMeta, error) {
+	accountKeys := message.AccountKeys
+	header := message.Header
 	accounts := make([]AccountMeta, len(accountKeys))
 	for i, key := range accountKeys {
 		accounts[i] = AccountMeta{
@@ -476,29 +576,124 @@ func getInstructionAccounts(accountKeys []Pubkey, header MessageHeader) []Accoun
 			Writable: i < header.NumRequiredSignatures-header.NumReadonlySignedAccounts || (i >= header.NumRequiredSignatures && i < len(accountKeys)-header.NumReadonlyUnsignedAccounts),
 		}
 	}
-	return accounts
+	if len(message.AddressTableLookups) == 0 {
+		return accounts, nil
+	}
+	if len(loadedAddresses) == 0 {
+		return nil, errors.New("message loads accounts from address lookup tables, loaded addresses are required")
+	}
+
+	var numWritable, numReadonly int
+	for _, lookup := range message.AddressTableLookups {
+		numWritable += len(lookup.WritableIndexes)
+		numReadonly += len(lookup.ReadonlyIndexes)
+	}
+	loaded := loadedAddresses[0]
+	if len(loaded.Writable) != numWritable || len(loaded.Readonly) != numReadonly {
+		return nil, errors.New("loaded addresses do not match the address table lookups of the message")
+	}
+	for _, addresses := range []struct {
+		keys     []string
+		writable bool
+	}{{loaded.Writable, true}, {loaded.Readonly, false}} {
+		for _, address := range addresses.keys {
+			pubkey, err := ParsePubkey(address)
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, AccountMeta{Pubkey: pubkey, Writable: addresses.writable})
+		}
+	}
+	return accounts, nil
 }
 
-func (rawTx *RawTransaction) Sign(signer Signer) error {
-	data, err := rawTx.Message.Bytes()
-	if err != nil {
-		return err
+// Returns the addresses the message loads from the given lookup tables, which must include every table the message looks up.
+func (message RawMessage) LoadAddresses(lookupTables []AddressLookupTable) (LoadedAddresses, error) {
+	loaded := LoadedAddresses{Writable: []string{}, Readonly: []string{}}
+	for _, lookup := range message.AddressTableLookups {
+		i := slices.IndexFunc(lookupTables, func(table AddressLookupTable) bool {
+			return table.Key.String() == lookup.AccountKey.String()
+		})
+		if i < 0 {
+			return LoadedAddresses{}, fmt.Errorf("address lookup table %s not given", lookup.AccountKey)
+		}
+		addresses := lookupTables[i].Addresses
+		for _, indexes := range []struct {
+			indexes []int
+			loaded  *[]string
+		}{{lookup.WritableIndexes, &loaded.Writable}, {lookup.ReadonlyIndexes, &loaded.Readonly}} {
+			for _, index := range indexes.indexes {
+				if index >= len(addresses) {
+					return LoadedAddresses{}, fmt.Errorf("index %d out of bounds of address lookup table %s", index, lookup.AccountKey)
+				}
+				*indexes.loaded = append(*indexes.loaded, addresses[index].String())
+			}
+		}
 	}
-	signatureBytes, err := signer.Sign(data)
-	if err != nil {
-		return err
+	return loaded, nil
+}
+
+// Returns "legacy" or the version number.
+func (v TransactionVersion) String() string {
+	if v == TransactionVersionLegacy {
+		return "legacy"
 	}
-	signature := base58.Encode(signatureBytes)
-	rawTx.Signatures = append(rawTx.Signatures, signature)
-	return nil
+	return strconv.Itoa(int(v) - 1)
 }
 
-func (tx *Transaction) Sign(signer Signer) error {
-	rawTx := tx.Serialize()
-	if err := rawTx.Sign(signer); err != nil {
-		return err
+// Encodes the version the way the RPC API does, as "legacy" or the version number.
+func (v TransactionVersion) MarshalJSON() ([]byte, error) {
+	if v == TransactionVersionLegacy {
+		return []byte(`"legacy"`), nil
 	}
+	return []byte(v.String()), nil
+}
 
-	tx.Signatures = rawTx.Signatures
+func (v *TransactionVersion) UnmarshalJSON(data []byte) error {
+	var legacy string
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		if legacy != "legacy" {
+			return fmt.Errorf("unknown transaction version %q", legacy)
+		}
+		*v = TransactionVersionLegacy
+		return nil
+	}
+	var version uint8
+	if err := json.Unmarshal(data, &version); err != nil {
+		return fmt.Errorf("invalid transaction version %s", data)
+	}
+	if version != 0 {
+		return fmt.Errorf("unsupported transaction version %d", version)
+	}
+	*v = TransactionVersion0
 	return nil
 }
+
+// Layout of an address lookup table account: a u32 state discriminator, the table's metadata, then the addresses.
+const (
+	lookupTableStateLookupTable = 1
+	lookupTableMetaSize         = 56
+)
+
+// Decodes the data of an address lookup table account stored at key.
+func ParseAddressLookupTable(key Pubkey, data []byte) (AddressLookupTable, error) {
+	if len(data) < lookupTableMetaSize {
+		return AddressLookupTable{}, errors.New("not enough data to read address lookup table")
+	}
+	if state := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24; state != lookupTableStateLookupTable {
+		return AddressLookupTable{}, errors.New("account is not an initialized address lookup table")
+	}
+	data = data[lookupTableMetaSize:]
+	if len(data)%32 != 0 {
+		return AddressLookupTable{}, errors.New("invalid address lookup table data length")
+	}
+	addresses := make([]Pubkey, len(data)/32)
+	for i := range addresses {
+		address, err := ParsePubkeyBytes(data[i*32 : (i+1)*32])
+		if err != nil {
+			return AddressLookupTable{}, err
+		}
+		addresses[i] = address
+	}
+	return AddressLookupTable{Key: key, Addresses: addresses}, nil
+}
@@ -1,6 +1,7 @@
 package solana
 
 import (
+	"encoding/json"
 	"slices"
 	"testing"
 )
@@ -59,7 +60,10 @@ func TestRawTransaction(t *testing.T) {
 		},
 	}
 
-	rawTx := tx.Serialize()
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if rawTx.Message.AccountKeys[0].String() != "BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ" {
 		t.Fatal("Unexpected account key")
 	}
@@ -138,3 +142,175 @@ func TestRawTxToTransaction(t *testing.T) {
 		t.Fatal("Unexpected recent blockhash")
 	}
 }
+
+func TestVersionedTransaction(t *testing.T) {
+	payer := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	writable := MustParsePubkey("2u83Dx5qPV4QnujjJQv8v2SoqG1ixuAxPK5Jwhtkovd1")
+	readonly := MustParsePubkey("GR16g49y2fEjRQD612ryaXjNomRF2TCWoiMgspKtXqya")
+	static := MustParsePubkey("7Fg8XQBVY4z7gPzecGo7abbHZbHj3iFfGozXsz1VcvKk")
+	program := MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	table := AddressLookupTable{
+		Key:       MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY"),
+		Addresses: []Pubkey{readonly, program, writable, payer},
+	}
+	tx := Transaction{
+		Signatures: []string{"5WUMzKkDaSuLUj3RpbufHi2PLRPgkjkHhsJpLE7Q3a3fMNDkV579zDmWLfMTnw4my5cbHKicRhYDTQsoAidv8nYD"},
+		Message: Message{
+			Instructions: []Instruction{{
+				Accounts: []AccountMeta{
+					{Pubkey: payer, Signer: true, Writable: true},
+					{Pubkey: readonly},
+					{Pubkey: writable, Writable: true},
+					{Pubkey: static},
+				},
+				Data:      []byte{1, 2, 3},
+				ProgramID: program,
+			}},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+
+	rawTx, err := tx.Serialize(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := rawTx.Message
+	if message.Version != TransactionVersion0 {
+		t.Fatal("Expected a version 0 message, got", message.Version)
+	}
+	//Signers and programs stay in the static keys even if a table holds them, and readonly keys are sorted by their bytes
+	if len(message.AccountKeys) != 3 || message.AccountKeys[0].String() != payer.String() || message.AccountKeys[1].String() != program.String() || message.AccountKeys[2].String() != static.String() {
+		t.Fatal("Unexpected static keys", message.AccountKeys)
+	}
+	if len(message.AddressTableLookups) != 1 || slices.Compare(message.AddressTableLookups[0].WritableIndexes, []int{2}) != 0 || slices.Compare(message.AddressTableLookups[0].ReadonlyIndexes, []int{0}) != 0 {
+		t.Fatal("Unexpected lookups", message.AddressTableLookups)
+	}
+	if slices.Compare(message.Instructions[0].Accounts, []int{0, 4, 3, 2}) != 0 {
+		t.Fatal("Unexpected account indexes", message.Instructions[0].Accounts)
+	}
+
+	data, err := rawTx.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseTransactionData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Message.Version != TransactionVersion0 || len(parsed.Message.AddressTableLookups) != 1 || parsed.Message.AddressTableLookups[0].AccountKey.String() != table.Key.String() {
+		t.Fatal("Unexpected parsed message", parsed.Message)
+	}
+	newData, err := parsed.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Compare(data, newData) != 0 {
+		t.Fatal("Data does not match")
+	}
+
+	if _, err := parsed.Transaction(); err == nil {
+		t.Fatal("Expected an error without the loaded addresses")
+	}
+	loaded, err := parsed.Message.LoadAddresses([]AddressLookupTable{table})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolved, err := parsed.Transaction(loaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, account := range resolved.Message.Instructions[0].Accounts {
+		expected := tx.Message.Instructions[0].Accounts[i]
+		if account.Pubkey.String() != expected.Pubkey.String() || account.Signer != expected.Signer || account.Writable != expected.Writable {
+			t.Fatal("Unexpected account", i, account)
+		}
+	}
+	if resolved.Message.Version != TransactionVersion0 {
+		t.Fatal("Expected the version to be kept")
+	}
+}
+
+func TestLegacyMessageWithLookups(t *testing.T) {
+	message := RawMessage{
+		RecentBlockhash:     "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		AddressTableLookups: []MessageAddressTableLookup{{AccountKey: SystemProgram}},
+	}
+	if _, err := message.Bytes(); err == nil {
+		t.Fatal("Expected legacy messages to reject lookups")
+	}
+	if _, err := ParseMessageData([]byte{0x81, 1, 0, 0}); err == nil {
+		t.Fatal("Expected unsupported versions to be rejected")
+	}
+}
+
+func TestParseTransactionTrailingBytes(t *testing.T) {
+	payer := MustParsePubkey("BrX9Z85BbmXYMjvvuAWU8imwsAqutVQiDg9uNfTGkzrJ")
+	loaded := MustParsePubkey("2u83Dx5qPV4QnujjJQv8v2SoqG1ixuAxPK5Jwhtkovd1")
+	tx := Transaction{
+		Signatures: []string{"5WUMzKkDaSuLUj3RpbufHi2PLRPgkjkHhsJpLE7Q3a3fMNDkV579zDmWLfMTnw4my5cbHKicRhYDTQsoAidv8nYD"},
+		Message: Message{
+			Instructions:    []Instruction{SystemProgramInstructions().Transfer(payer, loaded, 1)},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	for _, tables := range [][]AddressLookupTable{nil, {{Key: VoteProgram, Addresses: []Pubkey{loaded}}}} {
+		rawTx, err := tx.Serialize(tables...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := rawTx.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseTransactionData(data); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseTransactionData(append(data, 0)); err == nil {
+			t.Fatal("Expected trailing bytes to be rejected, version", rawTx.Message.Version)
+		}
+	}
+}
+
+func TestTransactionVersionJSON(t *testing.T) {
+	var res struct {
+		Legacy *TransactionVersion `json:"legacy"`
+		V0     *TransactionVersion `json:"v0"`
+		Unset  *TransactionVersion `json:"unset"`
+	}
+	if err := json.Unmarshal([]byte(`{"legacy":"legacy","v0":0}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if *res.Legacy != TransactionVersionLegacy || *res.V0 != TransactionVersion0 || res.Unset != nil {
+		t.Fatal("Unexpected versions", res)
+	}
+	data, err := json.Marshal([]TransactionVersion{TransactionVersionLegacy, TransactionVersion0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `["legacy",0]` {
+		t.Fatal("Unexpected JSON", string(data))
+	}
+	var version TransactionVersion
+	if err := json.Unmarshal([]byte(`1`), &version); err == nil {
+		t.Fatal("Expected unsupported versions to be rejected")
+	}
+}
+
+func TestParseAddressLookupTable(t *testing.T) {
+	key := MustParsePubkey("BLrD8HqBy4vKNvkb28Bijg4y6s8tE49jyVFbfZnmesjY")
+	data := make([]byte, lookupTableMetaSize)
+	data[0] = lookupTableStateLookupTable
+	data = append(data, SystemProgram.Bytes()...)
+	data = append(data, VoteProgram.Bytes()...)
+
+	table, err := ParseAddressLookupTable(key, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Addresses) != 2 || table.Addresses[1].String() != VoteProgram.String() {
+		t.Fatal("Unexpected addresses", table.Addresses)
+	}
+	if _, err := ParseAddressLookupTable(key, data[:lookupTableMetaSize+1]); err == nil {
+		t.Fatal("Expected an error for truncated data")
+	}
+}
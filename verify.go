@@ -0,0 +1,156 @@
+package solana
+
+import (
+	"crypto/ed25519"
+
+	"github.com/mr-tron/base58"
+)
+
+// Bytes a serialized transaction may take: the size of a network packet without its IPv6 and UDP headers.
+const MaxTransactionSize = 1232
+
+// Rules of the runtime checked by RawTransaction.Sanitize and RawTransaction.Verify. Use them with errors.Is.
+// The error's Context holds the broken rule, e.g. "DuplicateAccountKey", the index of the offending signature, account key, instruction or lookup, if any, and the details.
+// Broken signatures also match ErrTransactionSignatureFailure, and every other rule but the size ErrTransactionSanitizeFailure, the errors the runtime rejects the transaction with.
+var (
+	ErrVerifyInvalidHeader       = newVerifyError(SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ACCOUNT_FOR_FEE, "InvalidHeader", "message header does not describe its account keys", ErrTransactionSanitizeFailure)
+	ErrVerifySignatureCount      = newVerifyError(SOLANA_ERROR__TRANSACTION__MESSAGE_SIGNATURES_MISMATCH, "SignatureCountMismatch", "number of signatures does not match the required signers", ErrTransactionSanitizeFailure)
+	ErrVerifyDuplicateAccountKey = newVerifyError(SOLANA_ERROR__TRANSACTION_ERROR__ACCOUNT_LOADED_TWICE, "DuplicateAccountKey", "account key is listed twice", ErrTransactionSanitizeFailure)
+	ErrVerifyInvalidAccountKey   = newVerifyError(SOLANA_ERROR__ADDRESSES__INVALID_BYTE_LENGTH, "InvalidAccountKey", "account key is not 32 bytes", ErrTransactionSanitizeFailure)
+	ErrVerifyTooManyAccounts     = newVerifyError(SOLANA_ERROR__TRANSACTION_ERROR__TOO_MANY_ACCOUNT_LOCKS, "TooManyAccounts", "message references more than 256 accounts", ErrTransactionSanitizeFailure)
+	ErrVerifyInvalidLookup       = newVerifyError(SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ADDRESS_LOOKUP_TABLE_DATA, "InvalidAddressTableLookup", "address table lookup is invalid", ErrTransactionSanitizeFailure)
+	ErrVerifyIndexOutOfBounds    = newVerifyError(SOLANA_ERROR__TRANSACTION_ERROR__INVALID_ACCOUNT_INDEX, "IndexOutOfBounds", "instruction account index is out of bounds", ErrTransactionSanitizeFailure)
+	ErrVerifyInvalidProgramID    = newVerifyError(SOLANA_ERROR__TRANSACTION_ERROR__INVALID_PROGRAM_FOR_EXECUTION, "InvalidProgramId", "program ID is not a static account key other than the fee payer", ErrTransactionSanitizeFailure)
+	ErrVerifyWritableProgram     = newVerifyError(SOLANA_ERROR__TRANSACTION__INVOKED_PROGRAMS_MUST_NOT_BE_WRITABLE, "WritableProgram", "invoked programs must not be writable", ErrTransactionSanitizeFailure)
+	ErrVerifyTransactionTooLarge = newVerifyError(SOLANA_ERROR__TRANSACTION__EXCEEDS_SIZE_LIMIT, "TransactionTooLarge", "transaction is larger than a packet", nil)
+	ErrVerifyMissingSignature    = newVerifyError(SOLANA_ERROR__TRANSACTION__SIGNATURES_MISSING, "MissingSignature", "required signer has not signed", ErrTransactionSignatureFailure)
+	ErrVerifyInvalidSignature    = newVerifyError(SOLANA_ERROR__JSON_RPC__SERVER_ERROR_TRANSACTION_SIGNATURE_VERIFICATION_FAILURE, "InvalidSignature", "signature does not match the message", ErrTransactionSignatureFailure)
+)
+
+func newVerifyError(code ErrorCode, rule string, message string, cause error) *SolanaError {
+	return &SolanaError{Code: code, Message: message, Context: map[string]any{"rule": rule}, Cause: cause}
+}
+
+// Returns sentinel with the rule it breaks and the context. index is -1 if the rule is not about a single item.
+func verifyError(sentinel *SolanaError, index int, context map[string]any) error {
+	context["rule"] = sentinel.Context["rule"]
+	if index >= 0 {
+		context["index"] = index
+	}
+	return errorWithContext(sentinel, context)
+}
+
+// Checks the structural rules the runtime enforces before executing a transaction: header counts consistent with the account keys, one signature per required signer, no duplicate account keys, account and program indexes in bounds, program IDs neither writable nor paying the fees, well formed lookups, and a serialized size of at most MaxTransactionSize bytes.
+// Accounts loaded from lookup tables are not known without the tables, so they are not checked for duplicates.
+func (rawTx RawTransaction) Sanitize() error {
+	message := rawTx.Message
+	header := message.Header
+	numKeys := len(message.AccountKeys)
+
+	if header.NumRequiredSignatures < 0 || header.NumReadonlySignedAccounts < 0 || header.NumReadonlyUnsignedAccounts < 0 ||
+		header.NumRequiredSignatures > 255 || header.NumReadonlySignedAccounts > 255 || header.NumReadonlyUnsignedAccounts > 255 {
+		return verifyError(ErrVerifyInvalidHeader, -1, map[string]any{"numRequiredSignatures": header.NumRequiredSignatures, "numReadonlySignedAccounts": header.NumReadonlySignedAccounts, "numReadonlyUnsignedAccounts": header.NumReadonlyUnsignedAccounts})
+	}
+	if header.NumRequiredSignatures+header.NumReadonlyUnsignedAccounts > numKeys {
+		return verifyError(ErrVerifyInvalidHeader, -1, map[string]any{"numRequiredSignatures": header.NumRequiredSignatures, "numReadonlyUnsignedAccounts": header.NumReadonlyUnsignedAccounts, "accountKeys": numKeys})
+	}
+	//The fee payer is the first signer and has to be writable
+	if header.NumReadonlySignedAccounts >= header.NumRequiredSignatures {
+		return verifyError(ErrVerifyInvalidHeader, -1, map[string]any{"numRequiredSignatures": header.NumRequiredSignatures, "numReadonlySignedAccounts": header.NumReadonlySignedAccounts})
+	}
+	if len(rawTx.Signatures) != header.NumRequiredSignatures {
+		return verifyError(ErrVerifySignatureCount, -1, map[string]any{"signatures": len(rawTx.Signatures), "numRequiredSignatures": header.NumRequiredSignatures})
+	}
+
+	seen := map[string]int{}
+	for i, key := range message.AccountKeys {
+		if key == nil || len(key.Bytes()) != ed25519.PublicKeySize {
+			return verifyError(ErrVerifyInvalidAccountKey, i, map[string]any{})
+		}
+		if first, ok := seen[string(key.Bytes())]; ok {
+			return verifyError(ErrVerifyDuplicateAccountKey, i, map[string]any{"address": key.String(), "firstIndex": first})
+		}
+		seen[string(key.Bytes())] = i
+	}
+
+	numLoaded := 0
+	if len(message.AddressTableLookups) > 0 && message.Version == TransactionVersionLegacy {
+		return verifyError(ErrVerifyInvalidLookup, -1, map[string]any{"version": message.Version})
+	}
+	for i, lookup := range message.AddressTableLookups {
+		if len(lookup.WritableIndexes) == 0 && len(lookup.ReadonlyIndexes) == 0 {
+			return verifyError(ErrVerifyInvalidLookup, i, map[string]any{"lookupTable": lookup.AccountKey.String()})
+		}
+		numLoaded += len(lookup.WritableIndexes) + len(lookup.ReadonlyIndexes)
+	}
+	if numKeys+numLoaded > maxMessageAccounts {
+		return verifyError(ErrVerifyTooManyAccounts, -1, map[string]any{"accounts": numKeys + numLoaded, "maxAccounts": maxMessageAccounts})
+	}
+
+	for i, instruction := range message.Instructions {
+		//Programs are never loaded from lookup tables, and the fee payer cannot be a program
+		if instruction.ProgramIDIndex <= 0 || instruction.ProgramIDIndex >= numKeys {
+			return verifyError(ErrVerifyInvalidProgramID, i, map[string]any{"programIDIndex": instruction.ProgramIDIndex})
+		}
+		if message.isWritable(instruction.ProgramIDIndex) {
+			return verifyError(ErrVerifyWritableProgram, i, map[string]any{"programID": message.AccountKeys[instruction.ProgramIDIndex].String()})
+		}
+		for _, account := range instruction.Accounts {
+			if account < 0 || account >= numKeys+numLoaded {
+				return verifyError(ErrVerifyIndexOutOfBounds, i, map[string]any{"accountIndex": account, "accounts": numKeys + numLoaded})
+			}
+		}
+	}
+
+	data, err := rawTx.Bytes()
+	if err != nil {
+		return err
+	}
+	if len(data) > MaxTransactionSize {
+		return verifyError(ErrVerifyTransactionTooLarge, -1, map[string]any{"size": len(data), "maxSize": MaxTransactionSize})
+	}
+	return nil
+}
+
+// Sanitizes the transaction, then checks that every required signer has signed the message with the key in its slot of the account keys.
+// Use it to check a transaction received from a wallet or another service before sending it.
+func (rawTx RawTransaction) Verify() error {
+	if err := rawTx.Sanitize(); err != nil {
+		return err
+	}
+	data, err := rawTx.Message.Bytes()
+	if err != nil {
+		return err
+	}
+	for i, signature := range rawTx.Signatures {
+		signer := rawTx.Message.AccountKeys[i]
+		if signature == "" {
+			return verifyError(ErrVerifyMissingSignature, i, map[string]any{"address": signer.String()})
+		}
+		signatureData, err := base58.Decode(signature)
+		if err != nil || len(signatureData) != signatureLength {
+			return verifyError(ErrVerifyInvalidSignature, i, map[string]any{"address": signer.String(), "signature": signature})
+		}
+		if !ed25519.Verify(signer.Bytes(), data, signatureData) {
+			return verifyError(ErrVerifyInvalidSignature, i, map[string]any{"address": signer.String()})
+		}
+	}
+	return nil
+}
+
+// Returns whether the account key at index is writable according to the header. Accounts loaded from lookup tables are writable if listed in the writable indexes.
+func (message RawMessage) isWritable(index int) bool {
+	header := message.Header
+	numKeys := len(message.AccountKeys)
+	if index < header.NumRequiredSignatures {
+		return index < header.NumRequiredSignatures-header.NumReadonlySignedAccounts
+	}
+	if index < numKeys {
+		return index < numKeys-header.NumReadonlyUnsignedAccounts
+	}
+	numWritableLoaded := 0
+	for _, lookup := range message.AddressTableLookups {
+		numWritableLoaded += len(lookup.WritableIndexes)
+	}
+	return index < numKeys+numWritableLoaded
+}
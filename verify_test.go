@@ -0,0 +1,119 @@
+package solana
+
+import (
+	"errors"
+	"testing"
+)
+
+// Returns a transfer signed by its payer, compiled to [payer, recipient, system program].
+func newSignedTransfer(t *testing.T) RawTransaction {
+	payer := newTestKeypair(t, 1)
+	tx := Transaction{
+		Message: Message{
+			Instructions:    []Instruction{SystemProgramInstructions().Transfer(payer.Pubkey, newTestKeypair(t, 2).Pubkey, 1)},
+			RecentBlockhash: "5X8Ak8LYQTdoXbDaEYUdBC5dZophA7fNbiSEgMFYd1Qa",
+		},
+	}
+	if err := tx.Sign(payer); err != nil {
+		t.Fatal(err)
+	}
+	rawTx, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rawTx
+}
+
+func TestVerify(t *testing.T) {
+	if err := newSignedTransfer(t).Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	rawTx := newSignedTransfer(t)
+	rawTx.Message.RecentBlockhash = "GHtXQBsoZHVnNFa9YevAzFr17DJjgHXk3ycTKD5xD3Zi"
+	err := rawTx.Verify()
+	if !errors.Is(err, ErrVerifyInvalidSignature) || !errors.Is(err, ErrTransactionSignatureFailure) {
+		t.Fatal("Expected a tampered message to fail verification, got", err)
+	}
+
+	rawTx = newSignedTransfer(t)
+	rawTx.Signatures[0] = ""
+	err = rawTx.Verify()
+	if !errors.Is(err, ErrVerifyMissingSignature) {
+		t.Fatal("Expected a missing signature to fail verification, got", err)
+	}
+	var solanaErr *SolanaError
+	if !errors.As(err, &solanaErr) || solanaErr.Code != SOLANA_ERROR__TRANSACTION__SIGNATURES_MISSING || solanaErr.Context["rule"] != "MissingSignature" || solanaErr.Context["index"] != 0 {
+		t.Fatal("Unexpected error", err)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(rawTx *RawTransaction)
+		expected error
+	}{
+		{
+			name:     "header counts more signers than keys",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.Header.NumReadonlyUnsignedAccounts = 3 },
+			expected: ErrVerifyInvalidHeader,
+		},
+		{
+			name:     "readonly fee payer",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.Header.NumReadonlySignedAccounts = 1 },
+			expected: ErrVerifyInvalidHeader,
+		},
+		{
+			name:     "extra signature",
+			modify:   func(rawTx *RawTransaction) { rawTx.Signatures = append(rawTx.Signatures, rawTx.Signatures[0]) },
+			expected: ErrVerifySignatureCount,
+		},
+		{
+			name:     "duplicate key",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.AccountKeys[1] = rawTx.Message.AccountKeys[0] },
+			expected: ErrVerifyDuplicateAccountKey,
+		},
+		{
+			name:     "account index out of bounds",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.Instructions[0].Accounts[1] = 3 },
+			expected: ErrVerifyIndexOutOfBounds,
+		},
+		{
+			name:     "fee payer as program",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.Instructions[0].ProgramIDIndex = 0 },
+			expected: ErrVerifyInvalidProgramID,
+		},
+		{
+			name:     "writable program",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.Header.NumReadonlyUnsignedAccounts = 0 },
+			expected: ErrVerifyWritableProgram,
+		},
+		{
+			name: "lookups in a legacy message",
+			modify: func(rawTx *RawTransaction) {
+				rawTx.Message.AddressTableLookups = []MessageAddressTableLookup{{AccountKey: VoteProgram, ReadonlyIndexes: []int{0}}}
+			},
+			expected: ErrVerifyInvalidLookup,
+		},
+		{
+			name:     "too large",
+			modify:   func(rawTx *RawTransaction) { rawTx.Message.Instructions[0].Data = make([]byte, MaxTransactionSize) },
+			expected: ErrVerifyTransactionTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rawTx := newSignedTransfer(t)
+			test.modify(&rawTx)
+			err := rawTx.Sanitize()
+			var solanaErr *SolanaError
+			if !errors.Is(err, test.expected) || !errors.As(err, &solanaErr) || solanaErr.Context["rule"] != test.expected.(*SolanaError).Context["rule"] {
+				t.Fatal("Expected", test.expected, "got", err)
+			}
+			if test.expected != ErrVerifyTransactionTooLarge && !errors.Is(err, ErrTransactionSanitizeFailure) {
+				t.Fatal("Expected a sanitize failure, got", err)
+			}
+		})
+	}
+}